@@ -2,10 +2,10 @@ package main
 
 import (
 	"context"
-	"encoding/hex"
+	"log"
+
 	"github.com/logocomune/wsjtx/message"
 	"github.com/logocomune/wsjtx/udpserver"
-	"log"
 )
 
 func main() {
@@ -15,14 +15,24 @@ func main() {
 		log.Fatal(err)
 	}
 	defer server.Close()
-	for r := range server.Read() {
-		parse, err := message.Parse(r)
-		if err != nil {
-			log.Println("Error:", err)
-		}
-		log.Printf("Message Type: %s\n", parse.ResponseType)
-		log.Printf("Raw message (hex): %s\n", hex.EncodeToString(r))
-		log.Printf("Decoded message: %+v\n", parse.Message)
-		log.Println("-----------------------------------------------------")
+
+	router := udpserver.NewRouter(log.Default())
+	defer router.Close()
+
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		log.Printf("Status from %s: %+v\n", m.Addr, s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		log.Printf("Decode %s %3d dB %s\n", d.FullTime.Format("15:04:05"), d.SNR, d.Message)
+	})
+	router.OnQSOLogged(func(m udpserver.Message, q message.QSOLoggedResponse) {
+		log.Printf("QSO logged: %+v\n", q)
+	}, udpserver.Isolated(0))
+	router.OnError(func(err error) {
+		log.Println("Error:", err)
+	})
+
+	if err := router.Serve(context.Background(), server); err != nil {
+		log.Println(err)
 	}
 }
@@ -0,0 +1,133 @@
+// Package adif reads and writes the ADIF records of LoggedADIF messages and log files.
+package adif
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/logocomune/wsjtx/band"
+)
+
+// Record is a QSO record. Field names are upper case, like CALL or GRIDSQUARE.
+type Record map[string]string
+
+// Get returns the value of the field name, in any case.
+func (r Record) Get(name string) string {
+	return r[strings.ToUpper(name)]
+}
+
+// Band returns the band of the contact, from FREQ in MHz when BAND is missing.
+func (r Record) Band() string {
+	if b := r.Get("BAND"); b != "" {
+		return b
+	}
+
+	if mhz, err := strconv.ParseFloat(r.Get("FREQ"), 64); err == nil {
+		return band.FromFrequency(uint64(mhz*1e6 + 0.5))
+	}
+
+	return ""
+}
+
+// Mode returns the mode of the contact, SUBMODE when set, as WSJT-X logs FT4
+// as MODE MFSK and SUBMODE FT4.
+func (r Record) Mode() string {
+	if sub := r.Get("SUBMODE"); sub != "" {
+		return sub
+	}
+
+	return r.Get("MODE")
+}
+
+// Read reads the records of an ADIF file, skipping its header.
+func Read(r io.Reader) ([]Record, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(string(b))
+}
+
+// Parse parses the records of an ADIF text like the ADIF of LoggedADIF. The
+// text before <EOH> is the header and is skipped; a text starting with a
+// field has no header.
+func Parse(text string) ([]Record, error) {
+	var (
+		records []Record
+		current = Record{}
+		header  = !strings.HasPrefix(strings.TrimSpace(text), "<")
+	)
+
+	for {
+		start := strings.IndexByte(text, '<')
+		if start < 0 {
+			break
+		}
+
+		end := strings.IndexByte(text[start:], '>')
+		if end < 0 {
+			return nil, errors.New("adif: unterminated field")
+		}
+
+		spec := text[start+1 : start+end]
+		text = text[start+end+1:]
+
+		name, length, err := parseSpec(spec)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case name == "EOH":
+			header = false
+			current = Record{}
+		case name == "EOR":
+			if !header && len(current) > 0 {
+				records = append(records, current)
+			}
+
+			current = Record{}
+		default:
+			if length > len(text) {
+				return nil, fmt.Errorf("adif: field %s is longer than the data", name)
+			}
+
+			if !header {
+				current[name] = text[:length]
+			}
+
+			text = text[length:]
+		}
+	}
+
+	if len(current) > 0 {
+		return nil, errors.New("adif: record without <EOR>")
+	}
+
+	return records, nil
+}
+
+// parseSpec parses NAME:LENGTH[:TYPE], or NAME alone for EOH and EOR.
+func parseSpec(spec string) (string, int, error) {
+	parts := strings.Split(spec, ":")
+	name := strings.ToUpper(strings.TrimSpace(parts[0]))
+
+	if len(parts) == 1 {
+		if name != "EOH" && name != "EOR" {
+			return "", 0, fmt.Errorf("adif: field %s has no length", name)
+		}
+
+		return name, 0, nil
+	}
+
+	length, err := strconv.Atoi(parts[1])
+	if err != nil || length < 0 {
+		return "", 0, fmt.Errorf("adif: field %s has an invalid length %q", name, parts[1])
+	}
+
+	return name, length, nil
+}
@@ -0,0 +1,96 @@
+package adif
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    []Record
+		wantErr bool
+	}{
+		{
+			name: "logged adif",
+			text: "\n<adif_ver:5>3.1.0\n<programid:6>WSJT-X\n<EOH>\n<call:5>K1ABC <gridsquare:4>FN42 <mode:3>FT8 <band:3>20m <EOR>",
+			want: []Record{{"CALL": "K1ABC", "GRIDSQUARE": "FN42", "MODE": "FT8", "BAND": "20m"}},
+		},
+		{
+			name: "no header and types",
+			text: "<CALL:5:S>K1ABC<FREQ:9:N>14.075500<eor>\n<CALL:5>W9XYZ<EOR>",
+			want: []Record{{"CALL": "K1ABC", "FREQ": "14.075500"}, {"CALL": "W9XYZ"}},
+		},
+		{
+			name: "header only",
+			text: "Log export <PROGRAMID:6>WSJT-X <EOH>",
+		},
+		{name: "short data", text: "<CALL:9>K1ABC<EOR>", wantErr: true},
+		{name: "no eor", text: "<CALL:5>K1ABC", wantErr: true},
+		{name: "bad length", text: "<CALL:x>K1ABC<EOR>", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRead(t *testing.T) {
+	got, err := Read(strings.NewReader("<EOH><call:5>K1ABC<EOR>"))
+	if err != nil || len(got) != 1 || got[0].Get("call") != "K1ABC" {
+		t.Errorf("Read() = %v, %v", got, err)
+	}
+}
+
+func TestBandMode(t *testing.T) {
+	tests := []struct {
+		r    Record
+		band string
+		mode string
+	}{
+		{r: Record{"BAND": "20m", "MODE": "FT8"}, band: "20m", mode: "FT8"},
+		{r: Record{"FREQ": "7.047500", "MODE": "MFSK", "SUBMODE": "FT4"}, band: "40m", mode: "FT4"},
+		{r: Record{"FREQ": "x"}},
+	}
+	for _, tt := range tests {
+		if got, gotMode := tt.r.Band(), tt.r.Mode(); got != tt.band || gotMode != tt.mode {
+			t.Errorf("%v: Band(), Mode() = %q, %q, want %q, %q", tt.r, got, gotMode, tt.band, tt.mode)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	r := Record{"CALL": "K1ABC", "mode": "FT8", "COMMENT": ""}
+
+	if got, want := r.String(), "<CALL:5>K1ABC <MODE:3>FT8 <EOR>"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	records := []Record{{"CALL": "K1ABC", "GRIDSQUARE": "FN42"}, {"CALL": "W9XYZ", "COMMENT": "<tnx>"}}
+
+	var b strings.Builder
+	if err := Write(&b, "test", records); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Parse(b.String())
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("Parse(Write()) = %v, want %v\n%s", got, records, b.String())
+	}
+}
@@ -0,0 +1,47 @@
+package adif
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// String formats the record as ADIF fields ending with <EOR>. The fields are
+// sorted by name and the empty ones are left out.
+func (r Record) String() string {
+	names := make([]string, 0, len(r))
+	for name, value := range r {
+		if value != "" {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&b, "<%s:%d>%s ", strings.ToUpper(name), len(r[name]), r[name])
+	}
+
+	b.WriteString("<EOR>")
+
+	return b.String()
+}
+
+// Write writes an ADIF file with the records, after a header naming program.
+func Write(w io.Writer, program string, records []Record) error {
+	header := Record{"ADIF_VER": "3.1.0", "PROGRAMID": program}
+
+	if _, err := fmt.Fprintf(w, "%s\n%s\n", program, strings.TrimSuffix(header.String(), "<EOR>")+"<EOH>"); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if _, err := fmt.Fprintln(w, r.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,496 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/dxcc"
+	"github.com/logocomune/wsjtx/locator"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	queueSize      = 64
+	requestTimeout = 10 * time.Second
+)
+
+// Spot is a station heard by an instance.
+type Spot struct {
+	Instance string `json:"instance"`
+	Call     string `json:"call"`
+	Grid     string `json:"grid,omitempty"`
+	Band     string `json:"band,omitempty"`
+	Mode     string `json:"mode,omitempty"`
+	SNR      int    `json:"snr"`
+	// DXCC is the prefix of the entity, empty with Continent and the zones when unknown.
+	DXCC      string `json:"dxcc,omitempty"`
+	Continent string `json:"continent,omitempty"`
+	CQZone    int    `json:"cqZone,omitempty"`
+	ITUZone   int    `json:"ituZone,omitempty"`
+	// FrequencyHz is the dial frequency plus the audio offset.
+	FrequencyHz uint64 `json:"frequencyHz"`
+	// DistanceKm is 0 when the grid of the instance or of the spot is unknown.
+	DistanceKm float64 `json:"distanceKm,omitempty"`
+	// Kinds are the spot kinds, like cq and directed_cq.
+	Kinds   []string  `json:"kinds,omitempty"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+
+	distance bool
+}
+
+// Match is a spot selected by a rule.
+type Match struct {
+	Rule string `json:"rule"`
+	Spot Spot   `json:"spot"`
+}
+
+// Writer sends datagrams. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type station struct {
+	myCall string
+	myGrid string
+	dial   uint64
+	mode   string
+	addr   *net.UDPAddr
+}
+
+type cooldownKey struct {
+	rule     string
+	instance string
+	call     string
+	band     string
+}
+
+// job is a webhook request or a highlight, run by Run.
+type job func(ctx context.Context)
+
+// Engine matches the spots against the rules and runs their actions.
+type Engine struct {
+	rules     []*rule
+	log       logger
+	table     *dxcc.Table
+	callbacks map[string]func(Match)
+	client    *http.Client
+	w         Writer
+	jobs      chan job
+	mu        sync.Mutex
+	stations  map[string]*station
+	// fired has the end of the cooldown of the rules fired.
+	fired map[cooldownKey]time.Time
+}
+
+// Option configures an Engine.
+type Option func(*Engine)
+
+// WithTable sets the DXCC table, dxcc.Default() by default.
+func WithTable(t *dxcc.Table) Option {
+	return func(e *Engine) {
+		e.table = t
+	}
+}
+
+// WithCallback registers the callback run by the callback actions named name.
+// It runs on the dispatching goroutine and must not block.
+func WithCallback(name string, fn func(Match)) Option {
+	return func(e *Engine) {
+		e.callbacks[name] = fn
+	}
+}
+
+// WithHTTPClient sets the client of the webhooks.
+func WithHTTPClient(c *http.Client) Option {
+	return func(e *Engine) {
+		e.client = c
+	}
+}
+
+// WithWriter sets where the highlights are sent, needed by the highlight actions.
+func WithWriter(w Writer) Option {
+	return func(e *Engine) {
+		e.w = w
+	}
+}
+
+// NewEngine checks the rules and returns an engine running them.
+func NewEngine(rules []Rule, logger logger, opts ...Option) (*Engine, error) {
+	e := &Engine{
+		log:       logger,
+		table:     dxcc.Default(),
+		callbacks: make(map[string]func(Match)),
+		client:    &http.Client{Timeout: requestTimeout},
+		jobs:      make(chan job, queueSize),
+		stations:  make(map[string]*station),
+		fired:     make(map[cooldownKey]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	for _, r := range rules {
+		c, err := compile(r)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range c.actions {
+			if a.Type == ActionCallback && e.callbacks[a.Name] == nil {
+				return nil, fmt.Errorf("alert: rule %q: unknown callback %q", r.Name, a.Name)
+			}
+
+			if a.Type == ActionHighlight && e.w == nil {
+				return nil, fmt.Errorf("alert: rule %q: highlight without WithWriter", r.Name)
+			}
+		}
+
+		e.rules = append(e.rules, c)
+	}
+
+	return e, nil
+}
+
+// Register feeds the engine with the messages dispatched by router.
+func (e *Engine) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		e.Status(m.Addr, s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		e.Decode(m.Received, d)
+	})
+	router.OnWSPRDecode(func(m udpserver.Message, d message.WSPRDecodeResponse) {
+		e.WSPRDecode(m.Received, d)
+	})
+}
+
+// Run sends the webhooks and the highlights until ctx is done.
+func (e *Engine) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case j := <-e.jobs:
+			j(ctx)
+		}
+	}
+}
+
+// Status records the operator, grid, dial frequency and mode of the instance.
+func (e *Engine) Status(addr *net.UDPAddr, s message.StatusResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.stations[s.ID] = &station{myCall: s.DECall, myGrid: s.DEGrid, dial: s.Dial, mode: s.Mode, addr: addr}
+}
+
+// Decode matches the stations of a decode. Decodes are ignored until the
+// instance has sent a Status, which tells their band and mode.
+func (e *Engine) Decode(at time.Time, d message.DecodeResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	st, ok := e.stations[d.ID]
+	if !ok {
+		return
+	}
+
+	for _, m := range msgtext.ParseAll(d.Message) {
+		if m.From == "" {
+			continue
+		}
+
+		s := Spot{
+			Instance:    d.ID,
+			Call:        m.From,
+			Grid:        m.Grid,
+			Band:        band.FromFrequency(st.dial),
+			Mode:        st.mode,
+			SNR:         int(d.SNR),
+			FrequencyHz: st.dial + uint64(d.DeltaFrequencyHz),
+			Message:     d.Message,
+			Time:        at,
+		}
+
+		switch {
+		case m.Directed():
+			s.Kinds = []string{KindCQ, KindDirectedCQ}
+		case m.Kind == msgtext.CQ:
+			s.Kinds = []string{KindCQ}
+		case st.myCall != "" && m.To == st.myCall:
+			s.Kinds = []string{KindCallingMe}
+		}
+
+		e.spot(st, s)
+	}
+}
+
+// WSPRDecode matches a WSPR spot.
+func (e *Engine) WSPRDecode(at time.Time, d message.WSPRDecodeResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	st, ok := e.stations[d.ID]
+	if !ok {
+		st = &station{}
+	}
+
+	e.spot(st, Spot{
+		Instance:    d.ID,
+		Call:        strings.Trim(d.Callsign, "<>"),
+		Grid:        d.Grid,
+		Band:        band.FromFrequency(d.FrequencyHz),
+		Mode:        "WSPR",
+		SNR:         int(d.SNR),
+		FrequencyHz: d.FrequencyHz,
+		Kinds:       []string{KindWSPR},
+		Message:     strings.TrimSpace(fmt.Sprintf("%s %s %d", d.Callsign, d.Grid, d.PowerdBm)),
+		Time:        at,
+	})
+}
+
+func (e *Engine) spot(st *station, s Spot) {
+	if entity, ok := e.table.Lookup(s.Call); ok {
+		s.DXCC, s.Continent, s.CQZone, s.ITUZone = entity.Prefix, entity.Continent, entity.CQZone, entity.ITUZone
+	}
+
+	if st.myGrid != "" && s.Grid != "" {
+		if km, err := locator.GridDistance(st.myGrid, s.Grid); err == nil {
+			s.DistanceKm, s.distance = km, true
+		}
+	}
+
+	for _, r := range e.rules {
+		if !r.match(s) {
+			continue
+		}
+
+		k := cooldownKey{rule: r.Name, instance: s.Instance, call: s.Call, band: s.Band}
+		if until, ok := e.fired[k]; ok && s.Time.Before(until) {
+			continue
+		}
+
+		e.fired[k] = s.Time.Add(r.cooldown)
+		e.fire(r, st, Match{Rule: r.Name, Spot: s})
+	}
+
+	e.forget(s.Time)
+}
+
+// forget drops the cooldowns that are over.
+func (e *Engine) forget(now time.Time) {
+	for k, until := range e.fired {
+		if !now.Before(until) {
+			delete(e.fired, k)
+		}
+	}
+}
+
+func (r *rule) match(s Spot) bool {
+	call := strings.ToUpper(s.Call)
+
+	if r.Callsign != "" {
+		if !msgtext.MatchCall(r.Callsign, call) {
+			return false
+		}
+	}
+
+	if r.regex != nil && !r.regex.MatchString(call) {
+		return false
+	}
+
+	if len(r.DXCC) > 0 && !containsFold(r.DXCC, s.DXCC) {
+		return false
+	}
+
+	if len(r.Continent) > 0 && !containsFold(r.Continent, s.Continent) {
+		return false
+	}
+
+	if len(r.CQZone) > 0 && !containsInt(r.CQZone, s.CQZone) {
+		return false
+	}
+
+	if len(r.ITUZone) > 0 && !containsInt(r.ITUZone, s.ITUZone) {
+		return false
+	}
+
+	if len(r.Grid) > 0 && !hasPrefixFold(r.Grid, s.Grid) {
+		return false
+	}
+
+	if len(r.Band) > 0 && !containsFold(r.Band, s.Band) {
+		return false
+	}
+
+	if len(r.Mode) > 0 && !containsFold(r.Mode, s.Mode) {
+		return false
+	}
+
+	if (r.MinSNR != nil && s.SNR < *r.MinSNR) || (r.MaxSNR != nil && s.SNR > *r.MaxSNR) {
+		return false
+	}
+
+	if r.MinDistanceKm != nil || r.MaxDistanceKm != nil {
+		if !s.distance || (r.MinDistanceKm != nil && s.DistanceKm < *r.MinDistanceKm) ||
+			(r.MaxDistanceKm != nil && s.DistanceKm > *r.MaxDistanceKm) {
+			return false
+		}
+	}
+
+	if len(r.Kind) > 0 && !intersects(r.Kind, s.Kinds) {
+		return false
+	}
+
+	if len(r.windows) > 0 {
+		in := false
+		for _, w := range r.windows {
+			in = in || w.contains(s.Time)
+		}
+
+		if !in {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *Engine) fire(r *rule, st *station, m Match) {
+	for _, a := range r.actions {
+		switch a.Type {
+		case ActionLog:
+			e.log.Println("alert:", m.Rule+":", m.Spot.Instance, m.Spot.Band, m.Spot.Mode, m.Spot.SNR, "dB:", m.Spot.Message)
+		case ActionCallback:
+			e.callbacks[a.Name](m)
+		case ActionWebhook:
+			e.queue(m, e.webhook(a.URL, m))
+		case ActionHighlight:
+			if st.addr == nil {
+				continue
+			}
+
+			e.queue(m, e.highlight(st.addr, m, a))
+		}
+	}
+}
+
+func (e *Engine) queue(m Match, j job) {
+	select {
+	case e.jobs <- j:
+	default:
+		e.log.Println("alert: action dropped:", m.Rule, m.Spot.Call)
+	}
+}
+
+func (e *Engine) webhook(url string, m Match) job {
+	return func(ctx context.Context) {
+		body, err := json.Marshal(m)
+		if err != nil {
+			e.log.Println("alert: webhook:", err)
+
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			e.log.Println("alert: webhook:", err)
+
+			return
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := e.client.Do(req)
+		if err != nil {
+			e.log.Println("alert: webhook:", err)
+
+			return
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusMultipleChoices {
+			e.log.Println("alert: webhook:", url, resp.Status)
+		}
+	}
+}
+
+func (e *Engine) highlight(addr *net.UDPAddr, m Match, a action) job {
+	data := message.EncodeHighlightCallsign(message.HighlightCallsignMessage{
+		ID:              m.Spot.Instance,
+		Callsign:        m.Spot.Call,
+		BackgroundColor: a.background,
+		ForegroundColor: a.foreground,
+		HighlightLast:   true,
+	})
+
+	return func(ctx context.Context) {
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		if err := e.w.WriteTo(ctx, data, addr); err != nil {
+			e.log.Println("alert: highlight:", err)
+		}
+	}
+}
+
+func containsFold(l []string, s string) bool {
+	for _, v := range l {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPrefixFold(l []string, s string) bool {
+	s = strings.ToUpper(s)
+
+	for _, v := range l {
+		if s != "" && strings.HasPrefix(s, strings.ToUpper(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsInt(l []int, n int) bool {
+	for _, v := range l {
+		if v == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intersects(a, b []string) bool {
+	for _, v := range a {
+		if containsFold(b, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+type writer struct {
+	mu   sync.Mutex
+	sent []message.HighlightCallsignMessage
+}
+
+func (w *writer) WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error {
+	hl, err := message.ParseHighlightCallsign(msg)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.sent = append(w.sent, hl)
+
+	return nil
+}
+
+func intp(n int) *int { return &n }
+
+func floatp(f float64) *float64 { return &f }
+
+func TestEngine_Match(t *testing.T) {
+	rules := []Rule{
+		{Name: "oc-6m", Continent: []string{"OC"}, Band: []string{"6m"}, Kind: []string{KindCQ}, MinSNR: intp(-15), Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "k1abc", Callsign: "k1abc", Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "ea8", Callsign: "EA8/*", Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "dx-cq", Kind: []string{KindDirectedCQ}, CallsignRegex: "^W[0-9]", Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "me", Kind: []string{KindCallingMe}, Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "far", MinDistanceKm: floatp(10000), Grid: []string{"QF"}, Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+		{Name: "wspr-eu", Kind: []string{KindWSPR}, DXCC: []string{"g", "DL"}, CQZone: []int{14}, Actions: []Action{{Type: ActionCallback, Name: "c"}}},
+	}
+
+	var got []string
+
+	e, err := NewEngine(rules, log.New(io.Discard, "", 0), WithCallback("c", func(m Match) {
+		got = append(got, m.Rule+" "+m.Spot.Call)
+	}))
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	decode := func(id string, snr int32, text string) {
+		e.Decode(at, message.DecodeResponse{ID: id, SNR: snr, Message: text})
+	}
+
+	decode("six", -10, "CQ VK2ABC QF56")
+	e.Status(nil, message.StatusResponse{ID: "six", DECall: "K1ABC", DEGrid: "FN42", Dial: 50313000, Mode: "FT8"})
+	e.Status(nil, message.StatusResponse{ID: "hf", Dial: 14074000, Mode: "FT8"})
+
+	decode("six", -10, "CQ VK2ABC QF56")
+	decode("six", -20, "CQ VK3XYZ QF22")
+	decode("hf", -10, "CQ VK4XYZ QG62")
+	decode("hf", 0, "JA1XYZ K1ABC -10")
+	decode("hf", 0, "CQ DX W9XYZ EN37")
+	decode("hf", 0, "CQ N0DEF EN10")
+	decode("six", 0, "K1ABC JA1XYZ PM95")
+	decode("hf", 0, "CQ EA8/W9ABC")
+	e.WSPRDecode(at, message.WSPRDecodeResponse{ID: "hf", Callsign: "G4ABC", Grid: "IO91", FrequencyHz: 14097050, PowerdBm: 37})
+	e.WSPRDecode(at, message.WSPRDecodeResponse{ID: "hf", Callsign: "EA4ABC", Grid: "IN80", FrequencyHz: 14097050})
+
+	want := []string{"oc-6m VK2ABC", "far VK2ABC", "far VK3XYZ", "k1abc K1ABC", "dx-cq W9XYZ", "me JA1XYZ", "ea8 EA8/W9ABC", "wspr-eu G4ABC"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("matches = %v, want %v", got, want)
+	}
+
+	got = nil
+	decode("six", -10, "CQ VK2ABC QF56")
+	at = at.Add(DefaultCooldown)
+	decode("six", -10, "CQ VK2ABC QF56")
+
+	if want := []string{"oc-6m VK2ABC", "far VK2ABC"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("after cooldown matches = %v, want %v", got, want)
+	}
+}
+
+func TestEngine_Actions(t *testing.T) {
+	hooks := make(chan Match, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var m Match
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			t.Errorf("webhook body: %v", err)
+		}
+
+		hooks <- m
+	}))
+	defer srv.Close()
+
+	rules := []Rule{{
+		Name:     "k1abc",
+		Callsign: "K1ABC",
+		Actions: []Action{
+			{Type: ActionWebhook, URL: srv.URL},
+			{Type: ActionHighlight, Background: "#ff0000", Foreground: "#ffffff"},
+		},
+	}}
+
+	w := &writer{}
+
+	if _, err := NewEngine(rules, log.New(io.Discard, "", 0)); err == nil {
+		t.Error("NewEngine() highlight without writer: want error")
+	}
+
+	if _, err := NewEngine([]Rule{{Name: "a", Actions: []Action{{Type: ActionCallback, Name: "x"}}}}, log.New(io.Discard, "", 0)); err == nil {
+		t.Error("NewEngine() unknown callback: want error")
+	}
+
+	e, err := NewEngine(rules, log.New(io.Discard, "", 0), WithWriter(w))
+	if err != nil {
+		t.Fatalf("NewEngine() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go e.Run(ctx)
+
+	e.Status(&net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2237}, message.StatusResponse{ID: "WSJT-X", Dial: 7074000, Mode: "FT8"})
+	e.Decode(time.Now(), message.DecodeResponse{ID: "WSJT-X", SNR: -3, DeltaFrequencyHz: 1500, Message: "CQ K1ABC FN42"})
+
+	select {
+	case m := <-hooks:
+		if m.Rule != "k1abc" || m.Spot.Band != "40m" || m.Spot.FrequencyHz != 7075500 || m.Spot.DXCC != "K" {
+			t.Errorf("webhook = %+v", m)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not called")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		w.mu.Lock()
+		n := len(w.sent)
+		w.mu.Unlock()
+
+		if n > 0 || time.Now().After(deadline) {
+			break
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.sent) != 1 || w.sent[0].Callsign != "K1ABC" || w.sent[0].BackgroundColor.Red != 0xffff || w.sent[0].BackgroundColor.Green != 0 {
+		t.Errorf("highlights = %+v", w.sent)
+	}
+}
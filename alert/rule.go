@@ -0,0 +1,323 @@
+// Package alert matches the decodes and WSPR spots against rules and fires
+// their actions: callbacks, webhooks, highlights in WSJT-X and log lines.
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+)
+
+// Kinds of spots a rule can select.
+const (
+	// KindCQ is any CQ, directed or not.
+	KindCQ = "cq"
+	// KindDirectedCQ is a CQ with a modifier, like CQ DX or CQ POTA.
+	KindDirectedCQ = "directed_cq"
+	// KindCallingMe is a message sent to the operator of the instance.
+	KindCallingMe = "calling_me"
+	// KindWSPR is a WSPR spot.
+	KindWSPR = "wspr"
+)
+
+// Action types.
+const (
+	ActionCallback  = "callback"
+	ActionWebhook   = "webhook"
+	ActionHighlight = "highlight"
+	ActionLog       = "log"
+)
+
+// DefaultCooldown is how long a rule stays quiet for a callsign on a band after firing.
+const DefaultCooldown = 5 * time.Minute
+
+const minutesDay = 24 * 60
+
+var days = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Config is the content of a rules file.
+type Config struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule selects spots. Empty fields match everything; all the set fields must match.
+type Rule struct {
+	Name string `json:"name"`
+	// Callsign is a glob pattern like K1* or EA8/*, matched with
+	// msgtext.MatchCall, and CallsignRegex a regular expression matched
+	// against the upper case callsign.
+	Callsign      string `json:"callsign,omitempty"`
+	CallsignRegex string `json:"callsignRegex,omitempty"`
+	// DXCC lists entity prefixes, like VK or EA8.
+	DXCC      []string `json:"dxcc,omitempty"`
+	Continent []string `json:"continent,omitempty"`
+	CQZone    []int    `json:"cqZone,omitempty"`
+	ITUZone   []int    `json:"ituZone,omitempty"`
+	// Grid lists locator prefixes, like FN or JO2.
+	Grid []string `json:"grid,omitempty"`
+	Band []string `json:"band,omitempty"`
+	Mode []string `json:"mode,omitempty"`
+	// MinSNR and MaxSNR are in dB.
+	MinSNR *int `json:"minSNR,omitempty"`
+	MaxSNR *int `json:"maxSNR,omitempty"`
+	// MinDistanceKm and MaxDistanceKm only match spots whose distance is
+	// known, from the grid of the instance and of the spot.
+	MinDistanceKm *float64 `json:"minDistanceKm,omitempty"`
+	MaxDistanceKm *float64 `json:"maxDistanceKm,omitempty"`
+	// Kind lists the spot kinds, like cq or calling_me.
+	Kind []string `json:"kind,omitempty"`
+	// Time lists the windows, in UTC, in which the rule is active.
+	Time []TimeWindow `json:"time,omitempty"`
+	// Cooldown is a duration like 10m, DefaultCooldown when empty.
+	Cooldown string   `json:"cooldown,omitempty"`
+	Actions  []Action `json:"actions"`
+}
+
+// TimeWindow is a daily window from From to To, like 22:00 to 06:00, on Days
+// like sat and sun, or every day. To may be 24:00, and a window ending when
+// it starts is the whole day.
+type TimeWindow struct {
+	From string   `json:"from"`
+	To   string   `json:"to"`
+	Days []string `json:"days,omitempty"`
+}
+
+// Action is run when a rule matches.
+type Action struct {
+	Type string `json:"type"`
+	// Name is the callback registered with WithCallback.
+	Name string `json:"name,omitempty"`
+	// URL receives the Match as JSON in a POST request.
+	URL string `json:"url,omitempty"`
+	// Background and Foreground are the highlight colors, like #ff0000.
+	Background string `json:"background,omitempty"`
+	Foreground string `json:"foreground,omitempty"`
+}
+
+// Load reads the rules of a JSON Config and checks them.
+func Load(r io.Reader) ([]Rule, error) {
+	var c Config
+
+	d := json.NewDecoder(r)
+	d.DisallowUnknownFields()
+
+	if err := d.Decode(&c); err != nil {
+		return nil, fmt.Errorf("alert: %w", err)
+	}
+
+	for _, rule := range c.Rules {
+		if _, err := compile(rule); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.Rules, nil
+}
+
+// LoadFile reads the rules of a JSON Config file.
+func LoadFile(name string) ([]Rule, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+type window struct {
+	from, to int
+	days     map[time.Weekday]bool
+}
+
+type action struct {
+	Action
+	background message.QColor
+	foreground message.QColor
+}
+
+type rule struct {
+	Rule
+	regex    *regexp.Regexp
+	windows  []window
+	cooldown time.Duration
+	actions  []action
+}
+
+func compile(r Rule) (*rule, error) {
+	c := &rule{Rule: r, cooldown: DefaultCooldown}
+
+	fail := func(format string, a ...interface{}) (*rule, error) {
+		return nil, fmt.Errorf("alert: rule %q: %s", r.Name, fmt.Sprintf(format, a...))
+	}
+
+	if r.Name == "" {
+		return fail("no name")
+	}
+
+	if r.CallsignRegex != "" {
+		re, err := regexp.Compile(r.CallsignRegex)
+		if err != nil {
+			return fail("%v", err)
+		}
+
+		c.regex = re
+	}
+
+	for _, b := range r.Band {
+		if !band.Valid(b) {
+			return fail("unknown band %q", b)
+		}
+	}
+
+	for _, k := range r.Kind {
+		if k != KindCQ && k != KindDirectedCQ && k != KindCallingMe && k != KindWSPR {
+			return fail("unknown kind %q", k)
+		}
+	}
+
+	for _, tw := range r.Time {
+		w, err := parseWindow(tw)
+		if err != nil {
+			return fail("%v", err)
+		}
+
+		c.windows = append(c.windows, w)
+	}
+
+	if r.Cooldown != "" {
+		d, err := time.ParseDuration(r.Cooldown)
+		if err != nil || d < 0 {
+			return fail("invalid cooldown %q", r.Cooldown)
+		}
+
+		c.cooldown = d
+	}
+
+	if len(r.Actions) == 0 {
+		return fail("no actions")
+	}
+
+	for _, a := range r.Actions {
+		ca := action{Action: a}
+
+		switch a.Type {
+		case ActionLog:
+		case ActionCallback:
+			if a.Name == "" {
+				return fail("callback without name")
+			}
+		case ActionWebhook:
+			if !strings.HasPrefix(a.URL, "http://") && !strings.HasPrefix(a.URL, "https://") {
+				return fail("invalid webhook URL %q", a.URL)
+			}
+		case ActionHighlight:
+			var err error
+			if ca.background, err = parseColor(a.Background); err != nil {
+				return fail("%v", err)
+			}
+
+			if ca.foreground, err = parseColor(a.Foreground); err != nil {
+				return fail("%v", err)
+			}
+		default:
+			return fail("unknown action %q", a.Type)
+		}
+
+		c.actions = append(c.actions, ca)
+	}
+
+	return c, nil
+}
+
+func parseWindow(tw TimeWindow) (window, error) {
+	w := window{}
+
+	var err error
+	if w.from, err = parseClock(tw.From); err != nil {
+		return w, err
+	}
+
+	// 24:00 ends a window at midnight.
+	if tw.To == "24:00" {
+		w.to = minutesDay
+	} else if w.to, err = parseClock(tw.To); err != nil {
+		return w, err
+	}
+
+	if len(tw.Days) > 0 {
+		w.days = make(map[time.Weekday]bool)
+	}
+
+	for _, d := range tw.Days {
+		wd, ok := days[strings.ToLower(d)]
+		if !ok {
+			return w, fmt.Errorf("unknown day %q", d)
+		}
+
+		w.days[wd] = true
+	}
+
+	return w, nil
+}
+
+// parseClock returns the minutes since midnight of HH:MM.
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// contains reports whether t is in the window. A window ending before it
+// starts spans midnight and belongs to the day it starts; one ending when it
+// starts, like 00:00 to 00:00, is the whole day.
+func (w window) contains(t time.Time) bool {
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+
+	switch {
+	case w.from == w.to:
+	case w.from < w.to:
+		if minute < w.from || minute >= w.to {
+			return false
+		}
+	case minute >= w.from:
+	case minute < w.to:
+		day = (day + 6) % 7
+	default:
+		return false
+	}
+
+	return w.days == nil || w.days[day]
+}
+
+// parseColor reads #rrggbb as an opaque QColor; an empty string is the invalid color.
+func parseColor(s string) (message.QColor, error) {
+	if s == "" {
+		return message.QColor{}, nil
+	}
+
+	hex := strings.TrimPrefix(s, "#")
+
+	rgb, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 6 {
+		return message.QColor{}, fmt.Errorf("color %q is not #rrggbb", s)
+	}
+
+	return message.RGB(uint32(rgb)), nil
+}
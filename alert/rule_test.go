@@ -0,0 +1,107 @@
+package alert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: `{"rules": [{"name": "oc-6m", "continent": ["OC"], "band": ["6m"], "kind": ["cq"], "minSNR": -15,
+				"time": [{"from": "22:00", "to": "06:00", "days": ["sat", "sun"]}], "cooldown": "10m",
+				"actions": [{"type": "log"}, {"type": "webhook", "url": "https://example.com/hook"},
+				{"type": "highlight", "background": "#ff0000"}, {"type": "callback", "name": "notify"}]}]}`,
+		},
+		{name: "unknown field", config: `{"rules": [{"name": "a", "snr": 1, "actions": [{"type": "log"}]}]}`, wantErr: "unknown field"},
+		{name: "no name", config: `{"rules": [{"actions": [{"type": "log"}]}]}`, wantErr: "no name"},
+		{name: "no actions", config: `{"rules": [{"name": "a"}]}`, wantErr: "no actions"},
+		{name: "bad regex", config: `{"rules": [{"name": "a", "callsignRegex": "(", "actions": [{"type": "log"}]}]}`, wantErr: "missing closing"},
+		{name: "bad band", config: `{"rules": [{"name": "a", "band": ["11m"], "actions": [{"type": "log"}]}]}`, wantErr: "unknown band"},
+		{name: "bad kind", config: `{"rules": [{"name": "a", "kind": ["dx"], "actions": [{"type": "log"}]}]}`, wantErr: "unknown kind"},
+		{name: "bad time", config: `{"rules": [{"name": "a", "time": [{"from": "25:00", "to": "01:00"}], "actions": [{"type": "log"}]}]}`, wantErr: "invalid time"},
+		{name: "midnight start", config: `{"rules": [{"name": "a", "time": [{"from": "24:00", "to": "01:00"}], "actions": [{"type": "log"}]}]}`, wantErr: "invalid time"},
+		{name: "bad day", config: `{"rules": [{"name": "a", "time": [{"from": "01:00", "to": "02:00", "days": ["someday"]}], "actions": [{"type": "log"}]}]}`, wantErr: "unknown day"},
+		{name: "bad cooldown", config: `{"rules": [{"name": "a", "cooldown": "soon", "actions": [{"type": "log"}]}]}`, wantErr: "invalid cooldown"},
+		{name: "bad action", config: `{"rules": [{"name": "a", "actions": [{"type": "email"}]}]}`, wantErr: "unknown action"},
+		{name: "bad url", config: `{"rules": [{"name": "a", "actions": [{"type": "webhook", "url": "ftp://x"}]}]}`, wantErr: "invalid webhook"},
+		{name: "bad color", config: `{"rules": [{"name": "a", "actions": [{"type": "highlight", "foreground": "red"}]}]}`, wantErr: "not #rrggbb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := Load(strings.NewReader(tt.config))
+			if tt.wantErr == "" {
+				if err != nil || len(rules) != 1 {
+					t.Errorf("Load() = %v, %v", rules, err)
+				}
+
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWindow_Contains(t *testing.T) {
+	night, err := parseWindow(TimeWindow{From: "22:00", To: "06:00", Days: []string{"sat"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day, err := parseWindow(TimeWindow{From: "08:00", To: "12:30"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evening, err := parseWindow(TimeWindow{From: "18:00", To: "24:00"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sunday, err := parseWindow(TimeWindow{From: "00:00", To: "00:00", Days: []string{"sun"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2022-02-05 is a Saturday.
+	at := func(day int, hour, minute int) time.Time {
+		return time.Date(2022, 2, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		w    window
+		t    time.Time
+		want bool
+	}{
+		{name: "saturday night", w: night, t: at(5, 23, 0), want: true},
+		{name: "sunday early morning", w: night, t: at(6, 5, 59), want: true},
+		{name: "sunday night", w: night, t: at(6, 23, 0), want: false},
+		{name: "saturday early morning", w: night, t: at(5, 3, 0), want: false},
+		{name: "saturday end", w: night, t: at(6, 6, 0), want: false},
+		{name: "morning", w: day, t: at(7, 8, 0), want: true},
+		{name: "afternoon", w: day, t: at(7, 12, 30), want: false},
+		{name: "before midnight", w: evening, t: at(7, 23, 59), want: true},
+		{name: "after midnight", w: evening, t: at(8, 0, 0), want: false},
+		{name: "whole sunday", w: sunday, t: at(6, 0, 0), want: true},
+		{name: "sunday evening", w: sunday, t: at(6, 23, 59), want: true},
+		{name: "monday", w: sunday, t: at(7, 12, 0), want: false},
+		{name: "other zone", w: day, t: time.Date(2022, 2, 7, 10, 0, 0, 0, time.FixedZone("CET", 3600)), want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.w.contains(tt.t); got != tt.want {
+				t.Errorf("contains(%s) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
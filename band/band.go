@@ -0,0 +1,54 @@
+// Package band maps radio frequencies to amateur radio band names.
+package band
+
+// Band is an amateur radio band with its edges in Hz.
+type Band struct {
+	Name  string
+	Lower uint64
+	Upper uint64
+}
+
+// Bands lists the amateur radio bands, with the edges of the widest ITU region allocation.
+var Bands = []Band{
+	{Name: "2190m", Lower: 135_700, Upper: 137_800},
+	{Name: "630m", Lower: 472_000, Upper: 479_000},
+	{Name: "160m", Lower: 1_800_000, Upper: 2_000_000},
+	{Name: "80m", Lower: 3_500_000, Upper: 4_000_000},
+	{Name: "60m", Lower: 5_060_000, Upper: 5_450_000},
+	{Name: "40m", Lower: 7_000_000, Upper: 7_300_000},
+	{Name: "30m", Lower: 10_100_000, Upper: 10_150_000},
+	{Name: "20m", Lower: 14_000_000, Upper: 14_350_000},
+	{Name: "17m", Lower: 18_068_000, Upper: 18_168_000},
+	{Name: "15m", Lower: 21_000_000, Upper: 21_450_000},
+	{Name: "12m", Lower: 24_890_000, Upper: 24_990_000},
+	{Name: "10m", Lower: 28_000_000, Upper: 29_700_000},
+	{Name: "6m", Lower: 50_000_000, Upper: 54_000_000},
+	{Name: "4m", Lower: 70_000_000, Upper: 71_000_000},
+	{Name: "2m", Lower: 144_000_000, Upper: 148_000_000},
+	{Name: "1.25m", Lower: 222_000_000, Upper: 225_000_000},
+	{Name: "70cm", Lower: 420_000_000, Upper: 450_000_000},
+	{Name: "33cm", Lower: 902_000_000, Upper: 928_000_000},
+	{Name: "23cm", Lower: 1_240_000_000, Upper: 1_300_000_000},
+}
+
+// FromFrequency returns the name of the band containing hz, or an empty string.
+func FromFrequency(hz uint64) string {
+	for _, b := range Bands {
+		if hz >= b.Lower && hz <= b.Upper {
+			return b.Name
+		}
+	}
+
+	return ""
+}
+
+// Valid reports whether name is one of Bands.
+func Valid(name string) bool {
+	for _, b := range Bands {
+		if b.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,25 @@
+package band
+
+import "testing"
+
+func TestFromFrequency(t *testing.T) {
+	tests := []struct {
+		name string
+		hz   uint64
+		want string
+	}{
+		{name: "FT8 20m", hz: 14_074_000, want: "20m"},
+		{name: "FT8 40m", hz: 7_074_684, want: "40m"},
+		{name: "FT8 17m", hz: 18_100_000, want: "17m"},
+		{name: "FT8 6m", hz: 50_313_000, want: "6m"},
+		{name: "lower edge", hz: 1_800_000, want: "160m"},
+		{name: "out of band", hz: 15_000_000, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromFrequency(tt.hz); got != tt.want {
+				t.Errorf("FromFrequency() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
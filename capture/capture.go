@@ -0,0 +1,215 @@
+// Package capture records the datagrams exchanged by a udpserver to a compact
+// file and plays them back.
+//
+// A capture starts with the 8 bytes "WSJTXCAP" and a big endian uint16
+// version, followed by one record per datagram:
+//
+//	int64   receive or send time, in nanoseconds since the Unix epoch
+//	uint8   direction, 0 inbound and 1 outbound
+//	uint8   length of the peer address, followed by the address as "ip:port"
+//	uint32  length of the datagram, followed by the datagram
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	fileMagic   = "WSJTXCAP"
+	fileVersion = uint16(1)
+
+	maxAddrLen = 255
+)
+
+var (
+	ErrInvalidCapture = errors.New("capture: not a capture file")
+	ErrVersion        = errors.New("capture: unsupported version")
+	ErrRecordTooLarge = errors.New("capture: record too large")
+)
+
+// Record is a datagram of a capture.
+type Record struct {
+	Time      time.Time
+	Direction udpserver.Direction
+	Addr      *net.UDPAddr
+	Data      []byte
+}
+
+// Packet returns the record as received by a udpserver.
+func (r Record) Packet() udpserver.Packet {
+	return udpserver.Packet{Data: r.Data, Addr: r.Addr, Received: r.Time}
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// Recorder writes records to a capture. It is safe for concurrent use.
+type Recorder struct {
+	mu  sync.Mutex
+	w   *bufio.Writer
+	err error
+}
+
+// NewRecorder writes the capture header to w.
+func NewRecorder(w io.Writer) (*Recorder, error) {
+	r := &Recorder{w: bufio.NewWriter(w)}
+
+	var v [2]byte
+	binary.BigEndian.PutUint16(v[:], fileVersion)
+	r.w.WriteString(fileMagic)
+	r.w.Write(v[:])
+
+	if err := r.w.Flush(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Record appends a record to the capture.
+func (r *Recorder) Record(rec Record) error {
+	addr := ""
+	if rec.Addr != nil {
+		addr = rec.Addr.String()
+	}
+
+	if len(addr) > maxAddrLen || uint64(len(rec.Data)) > uint64(^uint32(0)) {
+		return ErrRecordTooLarge
+	}
+
+	var hdr [10]byte
+	binary.BigEndian.PutUint64(hdr[:8], uint64(rec.Time.UnixNano()))
+	hdr[8] = byte(rec.Direction)
+	hdr[9] = byte(len(addr))
+
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(rec.Data)))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+
+	r.w.Write(hdr[:])
+	r.w.WriteString(addr)
+	r.w.Write(size[:])
+	r.w.Write(rec.Data)
+	r.err = r.w.Flush()
+
+	return r.err
+}
+
+// Tap returns a udpserver.Tap recording every datagram. Write errors are
+// logged once and stop the recording; they are returned by Err.
+func (r *Recorder) Tap(logger logger) udpserver.Tap {
+	return func(dir udpserver.Direction, p udpserver.Packet) {
+		r.mu.Lock()
+		failed := r.err != nil
+		r.mu.Unlock()
+
+		if failed {
+			return
+		}
+
+		if err := r.Record(Record{Time: p.Received, Direction: dir, Addr: p.Addr, Data: p.Data}); err != nil {
+			logger.Println("capture: recording stopped:", err)
+		}
+	}
+}
+
+// Err returns the first write error.
+func (r *Recorder) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+// Reader reads the records of a capture.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader checks the capture header of r.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+
+	var hdr [len(fileMagic) + 2]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return nil, ErrInvalidCapture
+	}
+
+	if string(hdr[:len(fileMagic)]) != fileMagic {
+		return nil, ErrInvalidCapture
+	}
+
+	if binary.BigEndian.Uint16(hdr[len(fileMagic):]) != fileVersion {
+		return nil, ErrVersion
+	}
+
+	return &Reader{r: br}, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the capture.
+func (r *Reader) Next() (Record, error) {
+	var hdr [10]byte
+	if _, err := io.ReadFull(r.r, hdr[:]); err != nil {
+		return Record{}, err
+	}
+
+	rec := Record{
+		Time:      time.Unix(0, int64(binary.BigEndian.Uint64(hdr[:8]))),
+		Direction: udpserver.Direction(hdr[8]),
+	}
+
+	addr := make([]byte, hdr[9])
+	if _, err := io.ReadFull(r.r, addr); err != nil {
+		return Record{}, unexpected(err)
+	}
+
+	if len(addr) > 0 {
+		a, err := net.ResolveUDPAddr("udp", string(addr))
+		if err != nil {
+			return Record{}, err
+		}
+
+		rec.Addr = a
+	}
+
+	var size [4]byte
+	if _, err := io.ReadFull(r.r, size[:]); err != nil {
+		return Record{}, unexpected(err)
+	}
+
+	n := binary.BigEndian.Uint32(size[:])
+	if n > udpserver.MaxDatagramSize {
+		return Record{}, ErrRecordTooLarge
+	}
+
+	rec.Data = make([]byte, n)
+	if _, err := io.ReadFull(r.r, rec.Data); err != nil {
+		return Record{}, unexpected(err)
+	}
+
+	return rec, nil
+}
+
+// unexpected turns io.EOF in the middle of a record into io.ErrUnexpectedEOF.
+func unexpected(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,150 @@
+package capture
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const testDecode = `adbccbda00000002000000020000000657534a542d5801021b3ee0fffffff1bfb99999a000000000000581000000017e000000105858585858205959595959204c4f31310000`
+
+var testLogger = log.New(io.Discard, "", 0)
+
+func TestRecorder_Tap(t *testing.T) {
+	var buf bytes.Buffer
+
+	rec, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server, err := udpserver.NewServer(context.Background(), udpserver.Localhost, 0, testLogger, udpserver.WithTap(rec.Tap(testLogger)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	decode, _ := hex.DecodeString(testDecode)
+	if _, err := client.Write(decode); err != nil {
+		t.Fatal(err)
+	}
+	<-server.Read()
+
+	halt := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X"})
+	if err := server.Write(context.Background(), halt); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []struct {
+		dir  udpserver.Direction
+		data []byte
+	}{{udpserver.Inbound, decode}, {udpserver.Outbound, halt}} {
+		got, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Direction != want.dir || !bytes.Equal(got.Data, want.data) || got.Addr.String() != client.LocalAddr().String() {
+			t.Errorf("Next() = %+v, want %s %x from %s", got, want.dir, want.data, client.LocalAddr())
+		}
+	}
+
+	if _, err := r.Next(); !errors.Is(err, io.EOF) {
+		t.Errorf("Next() at end = %v, want EOF", err)
+	}
+}
+
+func TestPlayer_Messages(t *testing.T) {
+	var buf bytes.Buffer
+
+	rec, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decode, _ := hex.DecodeString(testDecode)
+	addr := &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 55000}
+	at := time.Date(2022, 2, 4, 9, 49, 10, 0, time.UTC)
+
+	_ = rec.Record(Record{Time: at, Direction: udpserver.Inbound, Addr: addr, Data: decode})
+	_ = rec.Record(Record{Time: at.Add(time.Second), Direction: udpserver.Outbound, Addr: addr, Data: []byte{1}})
+	_ = rec.Record(Record{Time: at.Add(200 * time.Millisecond), Direction: udpserver.Inbound, Addr: addr, Data: []byte{0, 0, 0, 0}})
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	msgs, errs := NewPlayer(r, WithSpeed(4)).Messages(context.Background())
+
+	m := <-msgs
+	d := m.Message.(message.DecodeResponse)
+
+	if want := time.Date(2022, 2, 4, 9, 49, 0, 0, time.UTC); !d.FullTime.Equal(want) || !m.Received.Equal(at) {
+		t.Errorf("Messages() full time = %s received %s, want %s and %s", d.FullTime, m.Received, want, at)
+	}
+
+	if err := <-errs; !errors.Is(err, message.ErrInvalidMagic) {
+		t.Errorf("errors = %v, want %v", err, message.ErrInvalidMagic)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("playback took %s, want at least 50ms at speed 4", elapsed)
+	}
+
+	if _, ok := <-msgs; ok {
+		t.Error("messages not closed at the end of the capture")
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var buf bytes.Buffer
+
+	rec, err := NewRecorder(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decode, _ := hex.DecodeString(testDecode)
+	addr := &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 55000}
+	at := time.Date(2022, 2, 4, 10, 0, 0, 0, time.UTC)
+	_ = rec.Record(Record{Time: at, Direction: udpserver.Inbound, Addr: addr, Data: decode})
+
+	r, err := NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := udpserver.NewServerWithTransport(context.Background(), NewTransport(r, WithSpeed(AsFastAsPossible)), testLogger)
+	defer server.Close()
+
+	select {
+	case m := <-server.Messages():
+		if !m.Received.Equal(at) || m.Addr.String() != addr.String() {
+			t.Errorf("Messages() received %s from %s, want %s from %s", m.Received, m.Addr, at, addr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Messages() timeout")
+	}
+}
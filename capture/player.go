@@ -0,0 +1,161 @@
+package capture
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// RealTime plays a capture at the speed it was recorded.
+	RealTime = 1
+	// AsFastAsPossible plays a capture without waiting between records.
+	AsFastAsPossible = 0
+)
+
+// Player plays back the inbound records of a capture.
+type Player struct {
+	r        *Reader
+	speed    float64
+	outbound bool
+}
+
+// PlayerOption configures a Player.
+type PlayerOption func(*Player)
+
+// WithSpeed sets the playback speed: RealTime, a multiple of it, or AsFastAsPossible.
+func WithSpeed(speed float64) PlayerOption {
+	return func(p *Player) {
+		if speed >= 0 {
+			p.speed = speed
+		}
+	}
+}
+
+// WithOutbound also plays the datagrams that were sent to WSJT-X.
+func WithOutbound() PlayerOption {
+	return func(p *Player) {
+		p.outbound = true
+	}
+}
+
+func NewPlayer(r *Reader, opts ...PlayerOption) *Player {
+	p := &Player{r: r, speed: RealTime}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Play calls fn with each record, waiting between records as they were recorded
+// divided by the speed. It returns nil at the end of the capture.
+func (p *Player) Play(ctx context.Context, fn func(Record) error) error {
+	var first, start time.Time
+
+	for {
+		rec, err := p.r.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rec.Direction == udpserver.Outbound && !p.outbound {
+			continue
+		}
+
+		if first.IsZero() {
+			first, start = rec.Time, time.Now()
+		}
+
+		if p.speed > 0 {
+			at := start.Add(time.Duration(float64(rec.Time.Sub(first)) / p.speed))
+			if err := sleepUntil(ctx, at); err != nil {
+				return err
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := fn(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// Messages plays the capture as the stream of a udpserver: the records are
+// parsed at their recorded time, so decode times are the ones of the capture.
+// Both channels are closed at the end of the capture; the error of the
+// playback itself, if any, is the last one sent on the error channel.
+// They can be passed to udpserver.Router.Run.
+func (p *Player) Messages(ctx context.Context) (<-chan udpserver.Message, <-chan error) {
+	msgs := make(chan udpserver.Message)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(msgs)
+
+		err := p.Play(ctx, func(rec Record) error {
+			resp, err := message.ParseAt(rec.Data, rec.Time)
+			if err != nil {
+				select {
+				case errs <- &udpserver.ParseError{Err: err, Packet: rec.Packet()}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+
+				return nil
+			}
+
+			select {
+			case msgs <- udpserver.Message{Response: resp, Addr: rec.Addr, Received: rec.Time}:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			select {
+			case errs <- err:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return msgs, errs
+}
+
+// PlayTo sends the records to addr through conn, as WSJT-X would.
+func (p *Player) PlayTo(ctx context.Context, conn *net.UDPConn, addr *net.UDPAddr) error {
+	return p.Play(ctx, func(rec Record) error {
+		_, err := conn.WriteToUDP(rec.Data, addr)
+
+		return err
+	})
+}
+
+func sleepUntil(ctx context.Context, at time.Time) error {
+	d := time.Until(at)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
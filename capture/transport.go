@@ -0,0 +1,84 @@
+package capture
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// Transport is a udpserver.TimedPacketTransport replaying the inbound records of a capture.
+// Datagrams written to it are discarded. ReadFrom returns io.EOF at the end of the capture.
+type Transport struct {
+	records chan Record
+	err     error
+	cancel  context.CancelFunc
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewTransport starts playing r with the given options.
+func NewTransport(r *Reader, opts ...PlayerOption) *Transport {
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &Transport{
+		records: make(chan Record),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		defer close(t.records)
+
+		t.err = NewPlayer(r, opts...).Play(ctx, func(rec Record) error {
+			select {
+			case t.records <- rec:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	return t
+}
+
+func (t *Transport) ReadFromAt(p []byte) (int, *net.UDPAddr, time.Time, error) {
+	select {
+	case <-t.done:
+		return 0, nil, time.Time{}, net.ErrClosed
+	case rec, ok := <-t.records:
+		if !ok {
+			if t.err != nil {
+				return 0, nil, time.Time{}, t.err
+			}
+
+			return 0, nil, time.Time{}, io.EOF
+		}
+
+		return copy(p, rec.Data), rec.Addr, rec.Time, nil
+	}
+}
+
+func (t *Transport) ReadFrom(p []byte) (int, *net.UDPAddr, error) {
+	n, addr, _, err := t.ReadFromAt(p)
+
+	return n, addr, err
+}
+
+func (t *Transport) WriteTo(p []byte, _ *net.UDPAddr) (int, error) {
+	return len(p), nil
+}
+
+func (t *Transport) Close() error {
+	t.once.Do(func() {
+		t.cancel()
+		close(t.done)
+	})
+
+	return nil
+}
+
+func (t *Transport) LocalAddr() net.Addr {
+	return &net.UDPAddr{IP: net.IPv4zero}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+
+	"github.com/logocomune/wsjtx/capture"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func runRecord(ctx context.Context, args []string) error {
+	var (
+		lf  listenFlags
+		out string
+	)
+
+	fs := flag.NewFlagSet("record", flag.ContinueOnError)
+	lf.register(fs)
+	fs.StringVar(&out, "o", "capture.wsjtx", "capture file to write")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+
+	rec, err := capture.NewRecorder(f)
+	if err != nil {
+		f.Close()
+
+		return err
+	}
+
+	server, err := lf.server(ctx, udpserver.WithTap(rec.Tap(log.Default())))
+	if err != nil {
+		f.Close()
+
+		return err
+	}
+
+	// The datagrams are recorded by the tap; they only need to be consumed.
+	raw := server.Read()
+	n := 0
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case _, ok := <-raw:
+			if !ok {
+				break loop
+			}
+
+			n++
+		}
+	}
+
+	server.Close()
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "recorded %d datagrams to %s\n", n, out)
+
+	return rec.Err()
+}
+
+func runReplay(ctx context.Context, args []string) error {
+	var (
+		to     string
+		speed  float64
+		format string
+	)
+
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	fs.StringVar(&to, "to", "", "host:port to send the datagrams to; by default they are printed")
+	fs.Float64Var(&speed, "speed", capture.RealTime, "replay speed, 0 as fast as possible")
+	fs.StringVar(&format, "format", formatPretty, "output format when printing: pretty, json or hex")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() != 1 {
+		return errors.New("usage: wsjtx replay [flags] capture.wsjtx")
+	}
+
+	f, err := os.Open(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := capture.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	if to == "" {
+		return printCapture(ctx, r, speed, format)
+	}
+
+	addr, err := net.ResolveUDPAddr("udp", to)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.ListenUDP("udp", nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return capture.NewPlayer(r, capture.WithSpeed(speed)).PlayTo(ctx, conn, addr)
+}
+
+// printCapture prints the inbound records of r.
+func printCapture(ctx context.Context, r *capture.Reader, speed float64, format string) error {
+	return capture.NewPlayer(r, capture.WithSpeed(speed)).Play(ctx, func(rec capture.Record) error {
+		p := rec.Packet()
+
+		resp, err := message.ParseAt(p.Data, p.Received)
+		if err != nil && format != formatHex {
+			log.Println(&udpserver.ParseError{Err: err, Packet: p})
+
+			return nil
+		}
+
+		return printMessage(stdout, format, udpserver.Message{Response: resp, Addr: p.Addr, Received: p.Received}, p.Data)
+	})
+}
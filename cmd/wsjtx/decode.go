@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func runDecode(_ context.Context, args []string) error {
+	var format string
+
+	fs := flag.NewFlagSet("decode", flag.ContinueOnError)
+	fs.StringVar(&format, "format", formatPretty, "output format: pretty or json")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if format != formatPretty && format != formatJSON {
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	inputs := fs.Args()
+	if len(inputs) == 0 {
+		s := bufio.NewScanner(stdin)
+		s.Buffer(make([]byte, 0, 64<<10), 4*udpserver.MaxDatagramSize)
+
+		for s.Scan() {
+			if line := strings.TrimSpace(s.Text()); line != "" {
+				inputs = append(inputs, line)
+			}
+		}
+
+		if err := s.Err(); err != nil {
+			return err
+		}
+	}
+
+	failed := false
+
+	for _, in := range inputs {
+		if err := decodeOne(format, in); err != nil {
+			fmt.Fprintf(stdout, "error: %v\n", err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New("some datagrams cannot be decoded")
+	}
+
+	return nil
+}
+
+func decodeOne(format, in string) error {
+	data, err := decodeDatagram(in)
+	if err != nil {
+		return err
+	}
+
+	resp, err := message.Parse(data)
+	if err != nil {
+		return err
+	}
+
+	return printMessage(stdout, format, udpserver.Message{Response: resp, Received: time.Now()}, data)
+}
+
+// decodeDatagram reads a datagram written in hex, with or without spaces, or in base64.
+func decodeDatagram(s string) ([]byte, error) {
+	compact := strings.Join(strings.Fields(s), "")
+	compact = strings.TrimPrefix(strings.TrimPrefix(compact, "0x"), "0X")
+
+	if data, err := hex.DecodeString(compact); err == nil {
+		return data, nil
+	}
+
+	for _, enc := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+		if data, err := enc.DecodeString(compact); err == nil {
+			return data, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%.20q is neither hex nor base64", s)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+// Output formats.
+const (
+	formatPretty = "pretty"
+	formatJSON   = "json"
+	formatHex    = "hex"
+)
+
+// filter selects the printed messages. Empty fields match everything.
+type filter struct {
+	types    []string
+	instance string
+}
+
+func newFilter(types, instance string) filter {
+	f := filter{instance: instance}
+
+	for _, t := range strings.Split(types, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			f.types = append(f.types, t)
+		}
+	}
+
+	return f
+}
+
+func (f filter) match(resp message.Response) bool {
+	if f.instance != "" && f.instance != udpserver.ResponseID(resp) {
+		return false
+	}
+
+	if len(f.types) == 0 {
+		return true
+	}
+
+	for _, t := range f.types {
+		if strings.EqualFold(t, resp.ResponseType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// printMessage writes m, received as raw, in the given format.
+func printMessage(w io.Writer, format string, m udpserver.Message, raw []byte) error {
+	switch format {
+	case formatJSON:
+		data, err := json.Marshal(m)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "%s\n", data)
+
+		return err
+	case formatHex:
+		_, err := fmt.Fprintln(w, hex.EncodeToString(raw))
+
+		return err
+	default:
+		_, err := fmt.Fprintf(w, "%s %-10s %-12s %s\n", m.Received.Format("15:04:05"), m.ResponseType, m.InstanceID(), summary(m.Response))
+
+		return err
+	}
+}
+
+// summary describes the content of resp on one line.
+func summary(resp message.Response) string {
+	switch m := resp.Message.(type) {
+	case message.HeartbeatResponse:
+		return fmt.Sprintf("version %s, schema %d", strings.TrimSpace(m.Version+" "+m.Revision), m.MaxSchemaNumber)
+	case message.StatusResponse:
+		s := fmt.Sprintf("%.6f MHz %s", float64(m.Dial)/1e6, m.Mode)
+		if m.DXCall != "" {
+			s += " dx " + m.DXCall
+		}
+
+		if m.Transmitting {
+			s += " transmitting"
+		} else if m.Decoding {
+			s += " decoding"
+		}
+
+		return s
+	case message.DecodeResponse:
+		return fmt.Sprintf("%s %3d %4.1f %4d %s %s", m.FullTime.Format("150405"), m.SNR, m.DeltaTime, m.DeltaFrequencyHz, m.Mode, m.Message)
+	case message.ClearResponse:
+		return fmt.Sprintf("window %d", m.Windows)
+	case message.QSOLoggedResponse:
+		return fmt.Sprintf("%s %s %.6f MHz %s sent %s rcvd %s", m.DXCall, m.DXGrid, float64(m.TXFrequencyHz)/1e6, m.Mode, m.ReportSent, m.ReportReceived)
+	case message.CloseResponse:
+		return ""
+	case message.WSPRDecodeResponse:
+		return fmt.Sprintf("%s %3d %4.1f %.6f MHz %s %s %d dBm", m.FullTime.Format("150405"), m.SNR, m.DeltaTime, float64(m.FrequencyHz)/1e6, m.Callsign, m.Grid, m.PowerdBm)
+	case message.LoggedADIFResponse:
+		return strings.Join(strings.Fields(m.ADIF), " ")
+	default:
+		return fmt.Sprintf("%+v", resp.Message)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func runListen(ctx context.Context, args []string) error {
+	var (
+		lf       listenFlags
+		format   string
+		types    string
+		instance string
+	)
+
+	fs := flag.NewFlagSet("listen", flag.ContinueOnError)
+	lf.register(fs)
+	fs.StringVar(&format, "format", formatPretty, "output format: pretty, json or hex")
+	fs.StringVar(&types, "type", "", "comma separated message types to print, like DECODE,STATUS")
+	fs.StringVar(&instance, "instance", "", "print only the messages of this instance ID")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if format != formatPretty && format != formatJSON && format != formatHex {
+		return fmt.Errorf("unknown format %q", format)
+	}
+
+	server, err := lf.server(ctx)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	f := newFilter(types, instance)
+
+	if format == formatHex {
+		return listenRaw(ctx, server, f)
+	}
+
+	msgs, errs := server.Messages(), server.Errors()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			log.Println(err)
+		case m, ok := <-msgs:
+			if !ok {
+				return nil
+			}
+
+			if !f.match(m.Response) {
+				continue
+			}
+
+			if err := printMessage(stdout, format, m, nil); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// listenRaw prints the datagrams as received, unparseable ones included
+// when no filter is set.
+func listenRaw(ctx context.Context, server *udpserver.UDPServer, f filter) error {
+	raw := server.Read()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case data, ok := <-raw:
+			if !ok {
+				return nil
+			}
+
+			resp, err := message.Parse(data)
+			if err != nil && (len(f.types) > 0 || f.instance != "") {
+				continue
+			}
+
+			if err == nil && !f.match(resp) {
+				continue
+			}
+
+			m := udpserver.Message{Response: resp, Received: time.Now()}
+			if err := printMessage(stdout, formatHex, m, data); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Command wsjtx listens to, decodes, records and sends WSJT-X UDP messages.
+//
+// Usage:
+//
+//	wsjtx listen [-format pretty|json|hex] [-type DECODE,STATUS] [-instance WSJT-X]
+//	wsjtx decode [-format pretty|json] [datagram ...]
+//	wsjtx send <halt-tx|free-text|reply|configure|location|highlight|switch-config> [flags]
+//	wsjtx record [-o capture.wsjtx]
+//	wsjtx replay [-to host:port] [-speed 1] capture.wsjtx
+//	wsjtx stats [-interval 10s] [-json]
+//
+// Run a subcommand with -h for its flags.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"syscall"
+
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+type subcommand struct {
+	run   func(ctx context.Context, args []string) error
+	usage string
+}
+
+var subcommands = map[string]subcommand{
+	"listen": {run: runListen, usage: "print the messages received"},
+	"decode": {run: runDecode, usage: "parse hex or base64 datagrams from the arguments or stdin"},
+	"send":   {run: runSend, usage: "send a command to an instance"},
+	"record": {run: runRecord, usage: "record the received datagrams to a capture file"},
+	"replay": {run: runReplay, usage: "replay a capture file to an address, or print it"},
+	"stats":  {run: runStats, usage: "print the server statistics"},
+}
+
+// stdout and stdin are replaced by the tests.
+var (
+	stdout io.Writer = os.Stdout
+	stdin  io.Reader = os.Stdin
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("wsjtx: ")
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := subcommands[os.Args[1]]
+	if !ok {
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := cmd.run(ctx, os.Args[2:]); err != nil && !errors.Is(err, flag.ErrHelp) {
+		log.Fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: wsjtx <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+
+	names := make([]string, 0, len(subcommands))
+	for name := range subcommands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", name, subcommands[name].usage)
+	}
+}
+
+// listenFlags are the flags of the subcommands receiving from WSJT-X.
+type listenFlags struct {
+	addr  string
+	port  int
+	iface string
+	debug bool
+}
+
+func (l *listenFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&l.addr, "addr", udpserver.Localhost, "address to listen on, unicast or multicast")
+	fs.IntVar(&l.port, "port", udpserver.DefaultPort, "UDP port")
+	fs.StringVar(&l.iface, "iface", "", "network interface of the multicast group")
+	fs.BoolVar(&l.debug, "debug", false, "log the server internals")
+}
+
+func (l *listenFlags) server(ctx context.Context, opts ...udpserver.Option) (*udpserver.UDPServer, error) {
+	if l.iface != "" {
+		opts = append(opts, udpserver.WithInterface(l.iface))
+	}
+
+	return udpserver.NewServer(ctx, l.addr, l.port, l.logger(), opts...)
+}
+
+func (l *listenFlags) logger() *log.Logger {
+	if l.debug {
+		return log.Default()
+	}
+
+	return log.New(io.Discard, "", 0)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func TestDecodeDatagram(t *testing.T) {
+	data := message.EncodeHearthBeat(message.HeartbeatMessage{ID: "WSJT-X", MaxSchemaNumber: 3, Version: "2.5.2"})
+
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "hex", in: hex.EncodeToString(data)},
+		{name: "spaced hex", in: "0x" + strings.Join(strings.SplitAfter(hex.EncodeToString(data), "ab"), " ")},
+		{name: "base64", in: base64.StdEncoding.EncodeToString(data)},
+		{name: "raw base64", in: base64.RawStdEncoding.EncodeToString(data)},
+		{name: "garbage", in: "not a datagram!", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeDatagram(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("decodeDatagram(%q) error = %v", tt.in, err)
+			}
+
+			if !tt.wantErr && !bytes.Equal(got, data) {
+				t.Errorf("decodeDatagram(%q) = %x, want %x", tt.in, got, data)
+			}
+		})
+	}
+}
+
+func TestRunDecode(t *testing.T) {
+	var out bytes.Buffer
+
+	oldOut, oldIn := stdout, stdin
+	stdout = &out
+	stdin = strings.NewReader(hex.EncodeToString(message.EncodeHearthBeat(message.HeartbeatMessage{ID: "WSJT-X", Version: "2.5.2"})) + "\n")
+
+	t.Cleanup(func() {
+		stdout, stdin = oldOut, oldIn
+	})
+
+	if err := runDecode(context.Background(), []string{"-format", "json"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(out.String(), `"type":"HEARTBEAT","instance":"WSJT-X"`) {
+		t.Errorf("output = %s", out.String())
+	}
+}
+
+func TestFilter(t *testing.T) {
+	decode := message.Response{ResponseType: message.DecodeType, Message: message.DecodeResponse{ID: "JTDX"}}
+
+	tests := []struct {
+		types, instance string
+		want            bool
+	}{
+		{want: true},
+		{types: "decode,status", want: true},
+		{types: "STATUS", want: false},
+		{instance: "JTDX", want: true},
+		{types: "DECODE", instance: "WSJT-X", want: false},
+	}
+	for _, tt := range tests {
+		if got := newFilter(tt.types, tt.instance).match(decode); got != tt.want {
+			t.Errorf("filter(%q, %q) = %v, want %v", tt.types, tt.instance, got, tt.want)
+		}
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	c, err := parseColor("#ff8000")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (message.QColor{Alpha: 0xffff, Red: 0xffff, Green: 0x8080}); c != want {
+		t.Errorf("parseColor = %+v, want %+v", c, want)
+	}
+
+	if _, err := parseColor("red"); err == nil {
+		t.Error("parseColor(red) accepted")
+	}
+}
+
+func TestSendTo(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	server, err := udpserver.NewServer(ctx, udpserver.Localhost, 0, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	oldOut := stdout
+	stdout = io.Discard
+	t.Cleanup(func() { stdout = oldOut })
+
+	args := []string{"halt-tx", "-id", "WSJT-X", "-auto", "-to", server.LocalAddr().String()}
+	if err := runSend(ctx, args); err != nil {
+		t.Fatal(err)
+	}
+
+	want := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: true})
+
+	select {
+	case got := <-server.Read():
+		if !bytes.Equal(got, want) {
+			t.Errorf("received %x, want %x", got, want)
+		}
+	case <-ctx.Done():
+		t.Fatal("nothing received")
+	}
+}
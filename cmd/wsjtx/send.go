@@ -0,0 +1,307 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/command"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+// sendCommands maps the send subcommands to the command names.
+var sendCommands = map[string]string{
+	"halt-tx":       command.HaltTX,
+	"free-text":     command.FreeText,
+	"reply":         command.Reply,
+	"configure":     command.Configure,
+	"location":      command.Location,
+	"highlight":     command.HighlightCallsign,
+	"switch-config": command.SwitchConfiguration,
+}
+
+// sendFlags are the flags shared by the send subcommands.
+type sendFlags struct {
+	listenFlags
+	id   string
+	to   string
+	wait time.Duration
+}
+
+func runSend(ctx context.Context, args []string) error {
+	if len(args) == 0 || sendCommands[args[0]] == "" {
+		return errors.New("usage: wsjtx send <halt-tx|free-text|reply|configure|location|highlight|switch-config> [flags]")
+	}
+
+	name := args[0]
+
+	var sf sendFlags
+
+	fs := flag.NewFlagSet("send "+name, flag.ContinueOnError)
+	sf.register(fs)
+	fs.StringVar(&sf.id, "id", "", "ID of the instance; by default the first instance heard")
+	fs.StringVar(&sf.to, "to", "", "host:port WSJT-X listens on; by default the address the instance is heard from")
+	fs.DurationVar(&sf.wait, "wait", 30*time.Second, "how long to wait for the instance to be heard")
+
+	build := sendFlagSet(name, fs)
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if sf.to != "" {
+		if sf.id == "" {
+			return errors.New("-id is required with -to")
+		}
+
+		addr, err := net.ResolveUDPAddr("udp", sf.to)
+		if err != nil {
+			return err
+		}
+
+		return sendTo(ctx, sf, name, build, addr)
+	}
+
+	return sendToHeard(ctx, sf, name, build)
+}
+
+// sendTo sends the command from an ephemeral port to addr.
+func sendTo(ctx context.Context, sf sendFlags, name string, build func() interface{}, addr *net.UDPAddr) error {
+	data, err := encodeCommand(name, sf.id, build())
+	if err != nil {
+		return err
+	}
+
+	conn, err := udpserver.ListenUDP("", 0)
+	if err != nil {
+		return err
+	}
+
+	server := udpserver.NewServerWithTransport(ctx, conn, sf.logger())
+	defer server.Close()
+
+	return server.WriteTo(ctx, data, addr)
+}
+
+// sendToHeard waits for the instance to be heard and answers it.
+func sendToHeard(ctx context.Context, sf sendFlags, name string, build func() interface{}) error {
+	server, err := sf.server(ctx)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, sf.wait)
+	defer cancel()
+
+	msgs := server.Messages()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if sf.id == "" {
+				return errors.New("no instance heard")
+			}
+
+			return fmt.Errorf("instance %q not heard", sf.id)
+		case m, ok := <-msgs:
+			if !ok {
+				return udpserver.ErrClosed
+			}
+
+			id := m.InstanceID()
+			if id == "" || (sf.id != "" && id != sf.id) {
+				continue
+			}
+
+			data, err := encodeCommand(name, id, build())
+			if err != nil {
+				return err
+			}
+
+			if err := server.WriteTo(ctx, data, m.Addr); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(stdout, "sent %s to %s at %s\n", name, id, m.Addr)
+
+			return nil
+		}
+	}
+}
+
+// encodeCommand encodes m through the command package, validating it.
+func encodeCommand(name, id string, m interface{}) ([]byte, error) {
+	params, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	return command.Encode(sendCommands[name], id, params)
+}
+
+// sendFlagSet registers the flags of the named command and returns the
+// function building its message once they are parsed.
+func sendFlagSet(name string, fs *flag.FlagSet) func() interface{} {
+	switch name {
+	case "halt-tx":
+		var m message.HaltTXMessage
+
+		fs.BoolVar(&m.Auto, "auto", false, "only disable auto TX, at the end of the current transmission")
+
+		return func() interface{} { return m }
+	case "free-text":
+		var m message.FreeTextMessage
+
+		fs.StringVar(&m.Text, "text", "", "free text message")
+		fs.BoolVar(&m.Send, "send", false, "send the message at the next TX period")
+
+		return func() interface{} { return m }
+	case "reply":
+		var (
+			m         message.ReplyMessage
+			snr       int
+			modifiers string
+		)
+
+		fs.StringVar(&m.Message, "message", "", "text of the decode to reply to")
+		fs.Var(uint32Value{&m.MsSinceMN}, "time", "time of the decode, in ms since midnight")
+		fs.IntVar(&snr, "snr", 0, "SNR of the decode")
+		fs.Float64Var(&m.DeltaTime, "dt", 0, "delta time of the decode, in s")
+		fs.Var(uint32Value{&m.DeltaFrequencyHZ}, "df", "delta frequency of the decode, in Hz")
+		fs.StringVar(&m.Mode, "mode", "", "mode of the decode")
+		fs.BoolVar(&m.LowConfidence, "low-confidence", false, "the decode is low confidence")
+		fs.StringVar(&modifiers, "modifiers", "", "comma separated keyboard modifiers: shift, control, alt, meta, keypad, group")
+
+		return func() interface{} {
+			m.SNR = int32(snr)
+			m.Modifiers = parseModifiers(modifiers)
+
+			return m
+		}
+	case "configure":
+		var m message.ConfigurationMessage
+
+		fs.StringVar(&m.Mode, "mode", "", "mode")
+		fs.Var(uint32Value{&m.FrequencyTolerance}, "tolerance", "frequency tolerance, in Hz")
+		fs.StringVar(&m.Submode, "submode", "", "submode")
+		fs.BoolVar(&m.FastMode, "fast", false, "fast mode")
+		fs.Var(uint32Value{&m.TRPeriod}, "period", "T/R period, in s")
+		fs.Var(uint32Value{&m.RXDF}, "rxdf", "RX audio frequency, in Hz")
+		fs.StringVar(&m.DXCall, "dx-call", "", "DX callsign")
+		fs.StringVar(&m.DXGrid, "dx-grid", "", "DX grid")
+		fs.BoolVar(&m.GenerateMessage, "generate", false, "generate the standard messages")
+
+		return func() interface{} { return m }
+	case "location":
+		var m message.LocationMessage
+
+		fs.StringVar(&m.Location, "grid", "", "Maidenhead locator")
+
+		return func() interface{} { return m }
+	case "highlight":
+		var m message.HighlightCallsignMessage
+
+		fs.StringVar(&m.Callsign, "call", "", "callsign to highlight")
+		fs.Var(colorValue{&m.BackgroundColor}, "bg", "background color, #rrggbb; unset clears it")
+		fs.Var(colorValue{&m.ForegroundColor}, "fg", "foreground color, #rrggbb; unset clears it")
+		fs.BoolVar(&m.HighlightLast, "last", false, "highlight only the last occurrence")
+
+		return func() interface{} { return m }
+	default:
+		var m message.SwitchConfigurationMessage
+
+		fs.StringVar(&m.ConfigurationName, "name", "", "configuration name")
+
+		return func() interface{} { return m }
+	}
+}
+
+var modifierNames = map[string]uint8{
+	"shift":   message.ReplyShiftModifier,
+	"control": message.ReplyControlModifier,
+	"alt":     message.ReplyAltModifier,
+	"meta":    message.ReplyMetaModifier,
+	"keypad":  message.ReplyKeypadModifier,
+	"group":   message.ReplyGroupModifier,
+}
+
+func parseModifiers(s string) uint8 {
+	m := message.ReplyNoModifier
+
+	for _, name := range strings.Split(s, ",") {
+		m |= modifierNames[strings.ToLower(strings.TrimSpace(name))]
+	}
+
+	return m
+}
+
+// uint32Value is a flag.Value setting a uint32.
+type uint32Value struct {
+	v *uint32
+}
+
+func (u uint32Value) String() string {
+	if u.v == nil {
+		return "0"
+	}
+
+	return strconv.FormatUint(uint64(*u.v), 10)
+}
+
+func (u uint32Value) Set(s string) error {
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	*u.v = uint32(n)
+
+	return nil
+}
+
+// colorValue is a flag.Value setting a QColor from #rrggbb.
+type colorValue struct {
+	c *message.QColor
+}
+
+func (c colorValue) String() string {
+	if c.c == nil || *c.c == (message.QColor{}) {
+		return ""
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", c.c.Red>>8, c.c.Green>>8, c.c.Blue>>8)
+}
+
+func (c colorValue) Set(s string) error {
+	qc, err := parseColor(s)
+	if err != nil {
+		return err
+	}
+
+	*c.c = qc
+
+	return nil
+}
+
+// parseColor reads #rrggbb as an opaque QColor.
+func parseColor(s string) (message.QColor, error) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) != 6 {
+		return message.QColor{}, fmt.Errorf("color %q is not #rrggbb", s)
+	}
+
+	rgb, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return message.QColor{}, fmt.Errorf("color %q is not #rrggbb", s)
+	}
+
+	return message.RGB(uint32(rgb)), nil
+}
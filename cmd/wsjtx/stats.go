@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func runStats(ctx context.Context, args []string) error {
+	var (
+		lf       listenFlags
+		interval time.Duration
+		asJSON   bool
+	)
+
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	lf.register(fs)
+	fs.DurationVar(&interval, "interval", 10*time.Second, "interval between the reports")
+	fs.BoolVar(&asJSON, "json", false, "print the full status as JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if interval <= 0 {
+		return fmt.Errorf("invalid interval %s", interval)
+	}
+
+	server, err := lf.server(ctx)
+	if err != nil {
+		return err
+	}
+	defer server.Close()
+
+	// The statistics are collected by the reader; messages and errors are only drained.
+	msgs, errs := server.Messages(), server.Errors()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return printStatus(stdout, server.GetStatus(), asJSON)
+		case <-msgs:
+		case <-errs:
+		case <-ticker.C:
+			if err := printStatus(stdout, server.GetStatus(), asJSON); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func printStatus(w io.Writer, s udpserver.Status, asJSON bool) error {
+	if asJSON {
+		data, err := json.Marshal(s)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(w, "%s\n", data)
+
+		return err
+	}
+
+	parseErrors := int64(0)
+	for _, n := range s.ParseErrors {
+		parseErrors += n
+	}
+
+	fmt.Fprintf(w, "uptime %s rx %d (%d B) tx %d (%d B) dropped %d truncated %d parse errors %d\n",
+		s.Uptime.Round(time.Second), s.RxMessages, s.RxBytes, s.TxMessages, s.TxBytes, s.Dropped, s.Truncated, parseErrors)
+
+	ids := make([]string, 0, len(s.Instances))
+	for id := range s.Instances {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		i := s.Instances[id]
+		fmt.Fprintf(w, "  %-12s %-21s %.6f MHz %-6s messages %d decodes %d\n", id, i.Addr, float64(i.Dial)/1e6, i.Mode, i.Messages, i.Decodes)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Package command encodes the messages sent to WSJT-X from a command name and
+// its JSON parameters, as used by the bridges and the HTTP API.
+package command
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+// Command names.
+const (
+	Reply               = "reply"
+	HaltTX              = "halt_tx"
+	FreeText            = "free_text"
+	HighlightCallsign   = "highlight_callsign"
+	Clear               = "clear"
+	Replay              = "replay"
+	Location            = "location"
+	SwitchConfiguration = "switch_configuration"
+	Configure           = "configure"
+)
+
+const maxClearWindows = 2
+
+// Names lists the supported commands.
+var Names = []string{Reply, HaltTX, FreeText, HighlightCallsign, Clear, Replay, Location, SwitchConfiguration, Configure}
+
+var ErrUnknownCommand = errors.New("command: unknown command")
+
+// ValidationError is returned when the parameters of a command are invalid.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return "command: " + e.Reason
+	}
+
+	return "command: " + e.Field + ": " + e.Reason
+}
+
+// Encode returns the datagram of the named command for the instance id.
+// The params are the JSON fields of the matching message struct, like
+// message.ReplyMessage for Reply; names are matched case-insensitively and
+// the ID field is set from id.
+func Encode(name, id string, params []byte) ([]byte, error) {
+	if id == "" {
+		return nil, &ValidationError{Field: "id", Reason: "required"}
+	}
+
+	switch name {
+	case Reply:
+		var m message.ReplyMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		if m.Message == "" {
+			return nil, &ValidationError{Field: "message", Reason: "required"}
+		}
+
+		m.ID = id
+
+		return message.EncodeReply(m), nil
+	case HaltTX:
+		var m message.HaltTXMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		m.ID = id
+
+		return message.EncodeHaltTX(m), nil
+	case FreeText:
+		var m message.FreeTextMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		m.ID = id
+
+		return message.EncodeFreeText(m), nil
+	case HighlightCallsign:
+		var m message.HighlightCallsignMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		if m.Callsign == "" {
+			return nil, &ValidationError{Field: "callsign", Reason: "required"}
+		}
+
+		m.ID = id
+
+		return message.EncodeHighlightCallsign(m), nil
+	case Clear:
+		var m message.ClearMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		// The protocol values are 0 band activity, 1 RX frequency, 2 both.
+		if m.Windows > maxClearWindows {
+			return nil, &ValidationError{Field: "windows", Reason: fmt.Sprintf("must be between 0 and %d", maxClearWindows)}
+		}
+
+		m.ID = id
+
+		return message.EncodeClear(m), nil
+	case Replay:
+		return message.EncodeReplay(message.ReplayMessage{ID: id}), nil
+	case Location:
+		var m message.LocationMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		if m.Location == "" {
+			return nil, &ValidationError{Field: "location", Reason: "required"}
+		}
+
+		m.ID = id
+
+		return message.EncodeLocation(m), nil
+	case SwitchConfiguration:
+		var m message.SwitchConfigurationMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		if m.ConfigurationName == "" {
+			return nil, &ValidationError{Field: "configurationName", Reason: "required"}
+		}
+
+		m.ID = id
+
+		return message.EncodeSwitchConfiguration(m), nil
+	case Configure:
+		var m message.ConfigurationMessage
+		if err := decode(params, &m); err != nil {
+			return nil, err
+		}
+
+		m.ID = id
+
+		return message.EncodeConfigure(m), nil
+	default:
+		return nil, ErrUnknownCommand
+	}
+}
+
+func decode(params []byte, v interface{}) error {
+	if len(bytes.TrimSpace(params)) == 0 {
+		return nil
+	}
+
+	d := json.NewDecoder(bytes.NewReader(params))
+	d.DisallowUnknownFields()
+
+	if err := d.Decode(v); err != nil {
+		return &ValidationError{Reason: err.Error()}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		params  string
+		want    []byte
+		wantErr error
+	}{
+		{
+			name:    "halt tx",
+			command: HaltTX,
+			params:  `{"auto":true}`,
+			want:    message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: true}),
+		},
+		{
+			name:    "free text",
+			command: FreeText,
+			params:  `{"text":"CQ TEST","send":false}`,
+			want:    message.EncodeFreeText(message.FreeTextMessage{ID: "WSJT-X", Text: "CQ TEST"}),
+		},
+		{
+			name:    "highlight",
+			command: HighlightCallsign,
+			params:  `{"callsign":"K1ABC","backgroundColor":{"alpha":65535,"red":65535}}`,
+			want: message.EncodeHighlightCallsign(message.HighlightCallsignMessage{
+				ID: "WSJT-X", Callsign: "K1ABC", BackgroundColor: message.QColor{Alpha: 65535, Red: 65535},
+			}),
+		},
+		{name: "replay without params", command: Replay, want: message.EncodeReplay(message.ReplayMessage{ID: "WSJT-X"})},
+		{name: "missing field", command: Location, params: `{}`, wantErr: &ValidationError{}},
+		{name: "unknown field", command: FreeText, params: `{"txt":"CQ"}`, wantErr: &ValidationError{}},
+		{name: "invalid clear", command: Clear, params: `{"windows":7}`, wantErr: &ValidationError{}},
+		{name: "unknown command", command: "reboot", wantErr: ErrUnknownCommand},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Encode(tt.command, "WSJT-X", []byte(tt.params))
+
+			var vErr *ValidationError
+			switch {
+			case tt.wantErr == nil && err != nil:
+				t.Fatalf("Encode() error = %v", err)
+			case errors.As(tt.wantErr, &vErr) && !errors.As(err, &vErr):
+				t.Fatalf("Encode() error = %v, want a ValidationError", err)
+			case errors.Is(tt.wantErr, ErrUnknownCommand) && !errors.Is(err, ErrUnknownCommand):
+				t.Fatalf("Encode() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Encode() = %x, want %x", got, tt.want)
+			}
+		})
+	}
+}
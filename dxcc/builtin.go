@@ -0,0 +1,161 @@
+package dxcc
+
+// builtin lists the most active DXCC entities with their usual prefixes.
+// Load a cty.dat file with LoadCTY for the full list and the exceptions.
+var builtin = []entry{
+	{Entity{Name: "Canada", Prefix: "VE", Code: 1, Continent: "NA", CQZone: 5, ITUZone: 9}, "VA VE VO VY"},
+	{Entity{Name: "United States", Prefix: "K", Code: 291, Continent: "NA", CQZone: 5, ITUZone: 8}, "AA AB AC AD AE AF AG AH AI AJ AK K N W"},
+	{Entity{Name: "Alaska", Prefix: "KL", Code: 6, Continent: "NA", CQZone: 1, ITUZone: 1}, "AL KL NL WL"},
+	{Entity{Name: "Hawaii", Prefix: "KH6", Code: 110, Continent: "OC", CQZone: 31, ITUZone: 61}, "AH6 AH7 KH6 KH7 NH6 NH7 WH6 WH7"},
+	{Entity{Name: "Guam", Prefix: "KH2", Code: 103, Continent: "OC", CQZone: 27, ITUZone: 64}, "AH2 KH2 NH2 WH2"},
+	{Entity{Name: "Mariana Islands", Prefix: "KH0", Code: 166, Continent: "OC", CQZone: 27, ITUZone: 64}, "AH0 KH0 NH0 WH0"},
+	{Entity{Name: "American Samoa", Prefix: "KH8", Code: 9, Continent: "OC", CQZone: 32, ITUZone: 62}, "AH8 KH8 NH8 WH8"},
+	{Entity{Name: "Puerto Rico", Prefix: "KP4", Code: 202, Continent: "NA", CQZone: 8, ITUZone: 11}, "KP3 KP4 NP3 NP4 WP3 WP4"},
+	{Entity{Name: "US Virgin Islands", Prefix: "KP2", Code: 285, Continent: "NA", CQZone: 8, ITUZone: 11}, "KP2 NP2 WP2"},
+	{Entity{Name: "Bermuda", Prefix: "VP9", Code: 64, Continent: "NA", CQZone: 5, ITUZone: 11}, "VP9"},
+	{Entity{Name: "Mexico", Prefix: "XE", Code: 50, Continent: "NA", CQZone: 6, ITUZone: 10}, "4A 4B 4C 6D 6E 6F 6G 6H 6I 6J XA XB XC XD XE XF XG XH XI"},
+	{Entity{Name: "Cuba", Prefix: "CM", Code: 70, Continent: "NA", CQZone: 8, ITUZone: 11}, "CL CM CO T4"},
+	{Entity{Name: "Dominican Republic", Prefix: "HI", Code: 72, Continent: "NA", CQZone: 8, ITUZone: 11}, "HI"},
+	{Entity{Name: "Jamaica", Prefix: "6Y", Code: 82, Continent: "NA", CQZone: 8, ITUZone: 11}, "6Y"},
+	{Entity{Name: "Bahamas", Prefix: "C6", Code: 60, Continent: "NA", CQZone: 8, ITUZone: 11}, "C6"},
+	{Entity{Name: "Barbados", Prefix: "8P", Code: 62, Continent: "NA", CQZone: 8, ITUZone: 11}, "8P"},
+	{Entity{Name: "Antigua & Barbuda", Prefix: "V2", Code: 94, Continent: "NA", CQZone: 8, ITUZone: 11}, "V2"},
+	{Entity{Name: "Guatemala", Prefix: "TG", Code: 76, Continent: "NA", CQZone: 7, ITUZone: 11}, "TD TG"},
+	{Entity{Name: "Honduras", Prefix: "HR", Code: 80, Continent: "NA", CQZone: 7, ITUZone: 11}, "HQ HR"},
+	{Entity{Name: "El Salvador", Prefix: "YS", Code: 74, Continent: "NA", CQZone: 7, ITUZone: 11}, "HU YS"},
+	{Entity{Name: "Nicaragua", Prefix: "YN", Code: 86, Continent: "NA", CQZone: 7, ITUZone: 11}, "H6 H7 YN"},
+	{Entity{Name: "Costa Rica", Prefix: "TI", Code: 308, Continent: "NA", CQZone: 7, ITUZone: 11}, "TE TI"},
+	{Entity{Name: "Panama", Prefix: "HP", Code: 88, Continent: "NA", CQZone: 7, ITUZone: 11}, "3E 3F H3 H8 H9 HO HP"},
+	{Entity{Name: "Trinidad & Tobago", Prefix: "9Y", Code: 90, Continent: "SA", CQZone: 9, ITUZone: 11}, "9Y 9Z"},
+	{Entity{Name: "Brazil", Prefix: "PY", Code: 108, Continent: "SA", CQZone: 11, ITUZone: 15}, "PP PQ PR PS PT PU PV PW PX PY ZV ZW ZX ZY ZZ"},
+	{Entity{Name: "Argentina", Prefix: "LU", Code: 100, Continent: "SA", CQZone: 13, ITUZone: 14}, "AY AZ L2 L3 L4 L5 L6 L7 L8 L9 LO LP LQ LR LS LT LU LV LW"},
+	{Entity{Name: "Chile", Prefix: "CE", Code: 112, Continent: "SA", CQZone: 12, ITUZone: 14}, "3G CA CB CC CD CE XQ XR"},
+	{Entity{Name: "Colombia", Prefix: "HK", Code: 116, Continent: "SA", CQZone: 9, ITUZone: 12}, "5J 5K HJ HK"},
+	{Entity{Name: "Venezuela", Prefix: "YV", Code: 148, Continent: "SA", CQZone: 9, ITUZone: 12}, "4M YV YW YX YY"},
+	{Entity{Name: "Peru", Prefix: "OA", Code: 136, Continent: "SA", CQZone: 10, ITUZone: 12}, "4T OA OB OC"},
+	{Entity{Name: "Ecuador", Prefix: "HC", Code: 120, Continent: "SA", CQZone: 10, ITUZone: 12}, "HC HD"},
+	{Entity{Name: "Uruguay", Prefix: "CX", Code: 144, Continent: "SA", CQZone: 13, ITUZone: 14}, "CV CW CX"},
+	{Entity{Name: "Paraguay", Prefix: "ZP", Code: 132, Continent: "SA", CQZone: 11, ITUZone: 14}, "ZP"},
+	{Entity{Name: "Bolivia", Prefix: "CP", Code: 104, Continent: "SA", CQZone: 10, ITUZone: 12}, "CP"},
+	{Entity{Name: "England", Prefix: "G", Code: 223, Continent: "EU", CQZone: 14, ITUZone: 27}, "2E G M"},
+	{Entity{Name: "Scotland", Prefix: "GM", Code: 279, Continent: "EU", CQZone: 14, ITUZone: 27}, "2M GM GS MM MS"},
+	{Entity{Name: "Wales", Prefix: "GW", Code: 294, Continent: "EU", CQZone: 14, ITUZone: 27}, "2W GC GW MC MW"},
+	{Entity{Name: "Northern Ireland", Prefix: "GI", Code: 265, Continent: "EU", CQZone: 14, ITUZone: 27}, "2I GI GN MI MN"},
+	{Entity{Name: "Isle of Man", Prefix: "GD", Code: 114, Continent: "EU", CQZone: 14, ITUZone: 27}, "2D GD GT MD MT"},
+	{Entity{Name: "Jersey", Prefix: "GJ", Code: 122, Continent: "EU", CQZone: 14, ITUZone: 27}, "2J GH GJ MH MJ"},
+	{Entity{Name: "Guernsey", Prefix: "GU", Code: 106, Continent: "EU", CQZone: 14, ITUZone: 27}, "2U GP GU MP MU"},
+	{Entity{Name: "Ireland", Prefix: "EI", Code: 245, Continent: "EU", CQZone: 14, ITUZone: 27}, "EI EJ"},
+	{Entity{Name: "France", Prefix: "F", Code: 227, Continent: "EU", CQZone: 14, ITUZone: 27}, "F TH TM"},
+	{Entity{Name: "Corsica", Prefix: "TK", Code: 214, Continent: "EU", CQZone: 15, ITUZone: 28}, "TK"},
+	{Entity{Name: "Germany", Prefix: "DL", Code: 230, Continent: "EU", CQZone: 14, ITUZone: 28}, "DA DB DC DD DE DF DG DH DI DJ DK DL DM DN DO DP DQ DR"},
+	{Entity{Name: "Belgium", Prefix: "ON", Code: 209, Continent: "EU", CQZone: 14, ITUZone: 27}, "ON OO OP OQ OR OS OT"},
+	{Entity{Name: "Netherlands", Prefix: "PA", Code: 263, Continent: "EU", CQZone: 14, ITUZone: 27}, "PA PB PC PD PE PF PG PH PI"},
+	{Entity{Name: "Luxembourg", Prefix: "LX", Code: 254, Continent: "EU", CQZone: 14, ITUZone: 27}, "LX"},
+	{Entity{Name: "Switzerland", Prefix: "HB", Code: 287, Continent: "EU", CQZone: 14, ITUZone: 28}, "HB HE"},
+	{Entity{Name: "Liechtenstein", Prefix: "HB0", Code: 251, Continent: "EU", CQZone: 14, ITUZone: 28}, "HB0 HE0"},
+	{Entity{Name: "Austria", Prefix: "OE", Code: 206, Continent: "EU", CQZone: 15, ITUZone: 28}, "OE"},
+	{Entity{Name: "Italy", Prefix: "I", Code: 248, Continent: "EU", CQZone: 15, ITUZone: 28}, "I"},
+	{Entity{Name: "Sardinia", Prefix: "IS0", Code: 225, Continent: "EU", CQZone: 15, ITUZone: 28}, "IM0 IS0"},
+	{Entity{Name: "Spain", Prefix: "EA", Code: 281, Continent: "EU", CQZone: 14, ITUZone: 37}, "EA EB EC ED EE EF EG EH"},
+	{Entity{Name: "Balearic Islands", Prefix: "EA6", Code: 21, Continent: "EU", CQZone: 14, ITUZone: 37}, "EA6 EB6 EC6 ED6 EE6 EF6 EG6 EH6"},
+	{Entity{Name: "Canary Islands", Prefix: "EA8", Code: 29, Continent: "AF", CQZone: 33, ITUZone: 36}, "EA8 EB8 EC8 ED8 EE8 EF8 EG8 EH8"},
+	{Entity{Name: "Ceuta & Melilla", Prefix: "EA9", Code: 32, Continent: "AF", CQZone: 33, ITUZone: 37}, "EA9 EB9 EC9 ED9 EE9 EF9 EG9 EH9"},
+	{Entity{Name: "Portugal", Prefix: "CT", Code: 272, Continent: "EU", CQZone: 14, ITUZone: 37}, "CQ CR CS CT"},
+	{Entity{Name: "Madeira Islands", Prefix: "CT3", Code: 256, Continent: "AF", CQZone: 33, ITUZone: 36}, "CQ3 CR3 CS3 CT3"},
+	{Entity{Name: "Azores", Prefix: "CU", Code: 149, Continent: "EU", CQZone: 14, ITUZone: 36}, "CU"},
+	{Entity{Name: "Andorra", Prefix: "C3", Code: 22, Continent: "EU", CQZone: 14, ITUZone: 27}, "C3"},
+	{Entity{Name: "Monaco", Prefix: "3A", Code: 260, Continent: "EU", CQZone: 14, ITUZone: 27}, "3A"},
+	{Entity{Name: "San Marino", Prefix: "T7", Code: 278, Continent: "EU", CQZone: 15, ITUZone: 28}, "T7"},
+	{Entity{Name: "Vatican City", Prefix: "HV", Code: 295, Continent: "EU", CQZone: 15, ITUZone: 28}, "HV"},
+	{Entity{Name: "Malta", Prefix: "9H", Code: 257, Continent: "EU", CQZone: 15, ITUZone: 28}, "9H"},
+	{Entity{Name: "Greece", Prefix: "SV", Code: 236, Continent: "EU", CQZone: 20, ITUZone: 28}, "J4 SV SW SX SY SZ"},
+	{Entity{Name: "Crete", Prefix: "SV9", Code: 40, Continent: "EU", CQZone: 20, ITUZone: 28}, "J49 SV9 SW9 SX9 SY9 SZ9"},
+	{Entity{Name: "Dodecanese", Prefix: "SV5", Code: 45, Continent: "EU", CQZone: 20, ITUZone: 28}, "J45 SV5 SW5 SX5 SY5 SZ5"},
+	{Entity{Name: "Turkey", Prefix: "TA", Code: 390, Continent: "AS", CQZone: 20, ITUZone: 39}, "TA TB TC YM"},
+	{Entity{Name: "Cyprus", Prefix: "5B", Code: 215, Continent: "AS", CQZone: 20, ITUZone: 39}, "5B C4 H2 P3"},
+	{Entity{Name: "Croatia", Prefix: "9A", Code: 497, Continent: "EU", CQZone: 15, ITUZone: 28}, "9A"},
+	{Entity{Name: "Slovenia", Prefix: "S5", Code: 499, Continent: "EU", CQZone: 15, ITUZone: 28}, "S5"},
+	{Entity{Name: "Bosnia-Herzegovina", Prefix: "E7", Code: 501, Continent: "EU", CQZone: 15, ITUZone: 28}, "E7"},
+	{Entity{Name: "Serbia", Prefix: "YU", Code: 296, Continent: "EU", CQZone: 15, ITUZone: 28}, "YT YU"},
+	{Entity{Name: "Montenegro", Prefix: "4O", Code: 514, Continent: "EU", CQZone: 15, ITUZone: 28}, "4O"},
+	{Entity{Name: "North Macedonia", Prefix: "Z3", Code: 502, Continent: "EU", CQZone: 15, ITUZone: 28}, "Z3"},
+	{Entity{Name: "Albania", Prefix: "ZA", Code: 7, Continent: "EU", CQZone: 15, ITUZone: 28}, "ZA"},
+	{Entity{Name: "Kosovo", Prefix: "Z6", Code: 522, Continent: "EU", CQZone: 15, ITUZone: 28}, "Z6"},
+	{Entity{Name: "Hungary", Prefix: "HA", Code: 239, Continent: "EU", CQZone: 15, ITUZone: 28}, "HA HG"},
+	{Entity{Name: "Czech Republic", Prefix: "OK", Code: 503, Continent: "EU", CQZone: 15, ITUZone: 28}, "OK OL"},
+	{Entity{Name: "Slovak Republic", Prefix: "OM", Code: 504, Continent: "EU", CQZone: 15, ITUZone: 28}, "OM"},
+	{Entity{Name: "Poland", Prefix: "SP", Code: 269, Continent: "EU", CQZone: 15, ITUZone: 28}, "3Z HF SN SO SP SQ SR"},
+	{Entity{Name: "Romania", Prefix: "YO", Code: 275, Continent: "EU", CQZone: 20, ITUZone: 28}, "YO YP YQ YR"},
+	{Entity{Name: "Bulgaria", Prefix: "LZ", Code: 212, Continent: "EU", CQZone: 20, ITUZone: 28}, "LZ"},
+	{Entity{Name: "Ukraine", Prefix: "UR", Code: 288, Continent: "EU", CQZone: 16, ITUZone: 29}, "EM EN EO UR US UT UU UV UW UX UY UZ"},
+	{Entity{Name: "Belarus", Prefix: "EU", Code: 27, Continent: "EU", CQZone: 16, ITUZone: 29}, "EU EV EW"},
+	{Entity{Name: "Moldova", Prefix: "ER", Code: 179, Continent: "EU", CQZone: 16, ITUZone: 29}, "ER"},
+	{Entity{Name: "Lithuania", Prefix: "LY", Code: 146, Continent: "EU", CQZone: 15, ITUZone: 29}, "LY"},
+	{Entity{Name: "Latvia", Prefix: "YL", Code: 145, Continent: "EU", CQZone: 15, ITUZone: 29}, "YL"},
+	{Entity{Name: "Estonia", Prefix: "ES", Code: 52, Continent: "EU", CQZone: 15, ITUZone: 29}, "ES"},
+	{Entity{Name: "Finland", Prefix: "OH", Code: 224, Continent: "EU", CQZone: 15, ITUZone: 18}, "OF OG OH OI"},
+	{Entity{Name: "Aland Islands", Prefix: "OH0", Code: 5, Continent: "EU", CQZone: 15, ITUZone: 18}, "OF0 OG0 OH0 OI0"},
+	{Entity{Name: "Sweden", Prefix: "SM", Code: 284, Continent: "EU", CQZone: 14, ITUZone: 18}, "7S 8S SA SB SC SD SE SF SG SH SI SJ SK SL SM"},
+	{Entity{Name: "Norway", Prefix: "LA", Code: 266, Continent: "EU", CQZone: 14, ITUZone: 18}, "LA LB LC LD LE LF LG LH LI LJ LK LL LM LN"},
+	{Entity{Name: "Svalbard", Prefix: "JW", Code: 259, Continent: "EU", CQZone: 40, ITUZone: 18}, "JW"},
+	{Entity{Name: "Jan Mayen", Prefix: "JX", Code: 118, Continent: "EU", CQZone: 40, ITUZone: 18}, "JX"},
+	{Entity{Name: "Denmark", Prefix: "OZ", Code: 221, Continent: "EU", CQZone: 14, ITUZone: 18}, "5P 5Q OU OV OW OZ"},
+	{Entity{Name: "Faroe Islands", Prefix: "OY", Code: 222, Continent: "EU", CQZone: 14, ITUZone: 18}, "OY"},
+	{Entity{Name: "Greenland", Prefix: "OX", Code: 237, Continent: "NA", CQZone: 40, ITUZone: 5}, "OX XP"},
+	{Entity{Name: "Iceland", Prefix: "TF", Code: 242, Continent: "EU", CQZone: 40, ITUZone: 17}, "TF"},
+	{Entity{Name: "European Russia", Prefix: "UA", Code: 54, Continent: "EU", CQZone: 16, ITUZone: 29}, "R RA RB RC RD RE RF RG RH RI RJ RK RL RM RN RO RP RQ RR RS RT RU RV RW RX RY RZ UA UB UC UD UE UF UG UH UI"},
+	{Entity{Name: "Kaliningrad", Prefix: "UA2", Code: 126, Continent: "EU", CQZone: 15, ITUZone: 29}, "R2 RA2 UA2"},
+	{Entity{Name: "Asiatic Russia", Prefix: "UA9", Code: 15, Continent: "AS", CQZone: 17, ITUZone: 30}, "R0 R8 R9 RA0 RA8 RA9 RB0 RB8 RB9 RC0 RC8 RC9 RD0 RD8 RD9 RE0 RE8 RE9 RF0 RF8 RF9 RG0 RG8 RG9 RH0 RH8 RH9 RI0 RI8 RI9 RJ0 RJ8 RJ9 RK0 RK8 RK9 RL0 RL8 RL9 RM0 RM8 RM9 RN0 RN8 RN9 RO0 RO8 RO9 RP0 RP8 RP9 RQ0 RQ8 RQ9 RR0 RR8 RR9 RS0 RS8 RS9 RT0 RT8 RT9 RU0 RU8 RU9 RV0 RV8 RV9 RW0 RW8 RW9 RX0 RX8 RX9 RY0 RY8 RY9 RZ0 RZ8 RZ9 UA0 UA8 UA9 UB0 UB8 UB9 UC0 UC8 UC9 UD0 UD8 UD9 UE0 UE8 UE9 UF0 UF8 UF9 UG0 UG8 UG9 UH0 UH8 UH9 UI0 UI8 UI9"},
+	{Entity{Name: "Kazakhstan", Prefix: "UN", Code: 130, Continent: "AS", CQZone: 17, ITUZone: 30}, "UN UO UP UQ"},
+	{Entity{Name: "Uzbekistan", Prefix: "UK", Code: 292, Continent: "AS", CQZone: 17, ITUZone: 30}, "UJ UK UL UM"},
+	{Entity{Name: "Armenia", Prefix: "EK", Code: 14, Continent: "AS", CQZone: 21, ITUZone: 29}, "EK"},
+	{Entity{Name: "Azerbaijan", Prefix: "4J", Code: 18, Continent: "AS", CQZone: 21, ITUZone: 29}, "4J 4K"},
+	{Entity{Name: "Georgia", Prefix: "4L", Code: 75, Continent: "AS", CQZone: 21, ITUZone: 29}, "4L"},
+	{Entity{Name: "Japan", Prefix: "JA", Code: 339, Continent: "AS", CQZone: 25, ITUZone: 45}, "7J 7K 7L 7M 7N 8J 8K 8L 8M 8N JA JB JC JD JE JF JG JH JI JJ JK JL JM JN JO JP JQ JR JS"},
+	{Entity{Name: "Republic of Korea", Prefix: "HL", Code: 137, Continent: "AS", CQZone: 25, ITUZone: 44}, "6K 6L 6M 6N D7 D8 D9 DS DT HL"},
+	{Entity{Name: "China", Prefix: "BY", Code: 318, Continent: "AS", CQZone: 24, ITUZone: 44}, "B"},
+	{Entity{Name: "Taiwan", Prefix: "BV", Code: 386, Continent: "AS", CQZone: 24, ITUZone: 44}, "BM BN BO BP BQ BU BV BW BX"},
+	{Entity{Name: "Hong Kong", Prefix: "VR2", Code: 321, Continent: "AS", CQZone: 24, ITUZone: 44}, "VR2"},
+	{Entity{Name: "Macao", Prefix: "XX9", Code: 152, Continent: "AS", CQZone: 24, ITUZone: 44}, "XX9"},
+	{Entity{Name: "Mongolia", Prefix: "JT", Code: 363, Continent: "AS", CQZone: 23, ITUZone: 32}, "JT JU JV"},
+	{Entity{Name: "India", Prefix: "VU", Code: 324, Continent: "AS", CQZone: 22, ITUZone: 41}, "8T 8U 8V 8W 8X 8Y AT AU AV AW VU"},
+	{Entity{Name: "Pakistan", Prefix: "AP", Code: 372, Continent: "AS", CQZone: 21, ITUZone: 41}, "6P 6Q 6R 6S AP"},
+	{Entity{Name: "Thailand", Prefix: "HS", Code: 387, Continent: "AS", CQZone: 26, ITUZone: 49}, "E2 HS"},
+	{Entity{Name: "Vietnam", Prefix: "3W", Code: 293, Continent: "AS", CQZone: 26, ITUZone: 49}, "3W XV"},
+	{Entity{Name: "West Malaysia", Prefix: "9M2", Code: 299, Continent: "AS", CQZone: 28, ITUZone: 54}, "9M2 9M4 9W2 9W4"},
+	{Entity{Name: "East Malaysia", Prefix: "9M6", Code: 46, Continent: "OC", CQZone: 28, ITUZone: 54}, "9M6 9M8 9W6 9W8"},
+	{Entity{Name: "Singapore", Prefix: "9V", Code: 381, Continent: "AS", CQZone: 28, ITUZone: 54}, "9V S6"},
+	{Entity{Name: "Indonesia", Prefix: "YB", Code: 327, Continent: "OC", CQZone: 28, ITUZone: 51}, "7A 7B 7C 7D 7E 7F 7G 7H 7I 8A 8B 8C 8D 8E 8F 8G 8H 8I PK PL PM PN PO YB YC YD YE YF YG YH"},
+	{Entity{Name: "Philippines", Prefix: "DU", Code: 375, Continent: "OC", CQZone: 27, ITUZone: 50}, "4D 4E 4F 4G 4H 4I DU DV DW DX DY DZ"},
+	{Entity{Name: "Israel", Prefix: "4X", Code: 336, Continent: "AS", CQZone: 20, ITUZone: 39}, "4X 4Z"},
+	{Entity{Name: "Jordan", Prefix: "JY", Code: 342, Continent: "AS", CQZone: 20, ITUZone: 39}, "JY"},
+	{Entity{Name: "Lebanon", Prefix: "OD", Code: 354, Continent: "AS", CQZone: 20, ITUZone: 39}, "OD"},
+	{Entity{Name: "Syria", Prefix: "YK", Code: 384, Continent: "AS", CQZone: 20, ITUZone: 39}, "6C YK"},
+	{Entity{Name: "Iraq", Prefix: "YI", Code: 333, Continent: "AS", CQZone: 21, ITUZone: 39}, "HN YI"},
+	{Entity{Name: "Iran", Prefix: "EP", Code: 330, Continent: "AS", CQZone: 21, ITUZone: 40}, "9B 9C 9D EP EQ"},
+	{Entity{Name: "Kuwait", Prefix: "9K", Code: 348, Continent: "AS", CQZone: 21, ITUZone: 39}, "9K"},
+	{Entity{Name: "Saudi Arabia", Prefix: "HZ", Code: 378, Continent: "AS", CQZone: 21, ITUZone: 39}, "7Z 8Z HZ"},
+	{Entity{Name: "United Arab Emirates", Prefix: "A6", Code: 391, Continent: "AS", CQZone: 21, ITUZone: 39}, "A6"},
+	{Entity{Name: "Qatar", Prefix: "A7", Code: 376, Continent: "AS", CQZone: 21, ITUZone: 39}, "A7"},
+	{Entity{Name: "Oman", Prefix: "A4", Code: 370, Continent: "AS", CQZone: 21, ITUZone: 39}, "A4"},
+	{Entity{Name: "Bahrain", Prefix: "A9", Code: 304, Continent: "AS", CQZone: 21, ITUZone: 39}, "A9"},
+	{Entity{Name: "Australia", Prefix: "VK", Code: 150, Continent: "OC", CQZone: 30, ITUZone: 59}, "AX VH VI VJ VK VL VM VN"},
+	{Entity{Name: "New Zealand", Prefix: "ZL", Code: 170, Continent: "OC", CQZone: 32, ITUZone: 60}, "ZL ZM"},
+	{Entity{Name: "Fiji", Prefix: "3D2", Code: 176, Continent: "OC", CQZone: 32, ITUZone: 56}, "3D2"},
+	{Entity{Name: "Papua New Guinea", Prefix: "P2", Code: 163, Continent: "OC", CQZone: 28, ITUZone: 51}, "P2"},
+	{Entity{Name: "New Caledonia", Prefix: "FK", Code: 162, Continent: "OC", CQZone: 32, ITUZone: 56}, "FK"},
+	{Entity{Name: "French Polynesia", Prefix: "FO", Code: 175, Continent: "OC", CQZone: 32, ITUZone: 63}, "FO"},
+	{Entity{Name: "South Africa", Prefix: "ZS", Code: 462, Continent: "AF", CQZone: 38, ITUZone: 57}, "ZR ZS ZT ZU"},
+	{Entity{Name: "Egypt", Prefix: "SU", Code: 478, Continent: "AF", CQZone: 34, ITUZone: 38}, "6A 6B SU"},
+	{Entity{Name: "Morocco", Prefix: "CN", Code: 446, Continent: "AF", CQZone: 33, ITUZone: 37}, "5C 5D 5E 5F 5G CN"},
+	{Entity{Name: "Algeria", Prefix: "7X", Code: 400, Continent: "AF", CQZone: 33, ITUZone: 37}, "7T 7U 7V 7W 7X 7Y"},
+	{Entity{Name: "Tunisia", Prefix: "3V", Code: 474, Continent: "AF", CQZone: 33, ITUZone: 37}, "3V TS"},
+	{Entity{Name: "Libya", Prefix: "5A", Code: 436, Continent: "AF", CQZone: 34, ITUZone: 38}, "5A"},
+	{Entity{Name: "Nigeria", Prefix: "5N", Code: 450, Continent: "AF", CQZone: 35, ITUZone: 46}, "5N 5O"},
+	{Entity{Name: "Ghana", Prefix: "9G", Code: 424, Continent: "AF", CQZone: 35, ITUZone: 46}, "9G"},
+	{Entity{Name: "Senegal", Prefix: "6W", Code: 456, Continent: "AF", CQZone: 35, ITUZone: 46}, "6V 6W"},
+	{Entity{Name: "Kenya", Prefix: "5Z", Code: 430, Continent: "AF", CQZone: 37, ITUZone: 48}, "5Y 5Z"},
+	{Entity{Name: "Tanzania", Prefix: "5H", Code: 470, Continent: "AF", CQZone: 37, ITUZone: 53}, "5H 5I"},
+	{Entity{Name: "Ethiopia", Prefix: "ET", Code: 53, Continent: "AF", CQZone: 37, ITUZone: 48}, "9E 9F ET"},
+	{Entity{Name: "Madagascar", Prefix: "5R", Code: 438, Continent: "AF", CQZone: 39, ITUZone: 53}, "5R 5S 6X"},
+}
@@ -0,0 +1,266 @@
+// Package dxcc resolves callsigns to their DXCC entity, continent and zones.
+package dxcc
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Entity is a DXCC entity. The zones and continent are those of the entity,
+// or of the prefix or callsign exception that matched in Lookup.
+type Entity struct {
+	Name string
+	// Prefix is the primary prefix, which identifies the entity.
+	Prefix string
+	// Code is the ADIF DXCC entity code, 0 when unknown as in cty.dat files.
+	Code      int
+	Continent string
+	CQZone    int
+	ITUZone   int
+}
+
+type entry struct {
+	entity   Entity
+	prefixes string
+}
+
+// Table maps prefixes and exact callsigns to entities.
+type Table struct {
+	prefixes map[string]Entity
+	calls    map[string]Entity
+	longest  int
+}
+
+var defaultTable = newTable(builtin)
+
+// Default returns the built-in table of the most active entities. It has no
+// callsign exceptions; LoadCTY reads a complete table.
+func Default() *Table {
+	return defaultTable
+}
+
+func newTable(entries []entry) *Table {
+	t := &Table{prefixes: make(map[string]Entity), calls: make(map[string]Entity)}
+
+	for _, e := range entries {
+		for _, p := range strings.Fields(e.prefixes) {
+			t.addPrefix(p, e.entity)
+		}
+	}
+
+	return t
+}
+
+func (t *Table) addPrefix(p string, e Entity) {
+	t.prefixes[p] = e
+	if len(p) > t.longest {
+		t.longest = len(p)
+	}
+}
+
+// Lookup returns the entity of call, trying the exact callsigns first and
+// then the longest matching prefix.
+func (t *Table) Lookup(call string) (Entity, bool) {
+	call = strings.ToUpper(strings.TrimSpace(call))
+	if e, ok := t.calls[call]; ok {
+		return e, true
+	}
+
+	p := base(call)
+	if p == "" {
+		return Entity{}, false
+	}
+
+	if e, ok := t.calls[p]; ok {
+		return e, true
+	}
+
+	n := len(p)
+	if n > t.longest {
+		n = t.longest
+	}
+
+	for ; n > 0; n-- {
+		if e, ok := t.prefixes[p[:n]]; ok {
+			return e, true
+		}
+	}
+
+	return Entity{}, false
+}
+
+// base returns the part of call that tells the entity: EA8 for EA8/K1ABC,
+// K1ABC for K1ABC/P. Maritime and aeronautical mobiles have no entity.
+func base(call string) string {
+	parts := strings.Split(call, "/")
+
+	switch len(parts) {
+	case 1:
+		return call
+	case 2, 3:
+	default:
+		return ""
+	}
+
+	first, second := parts[0], parts[1]
+
+	switch second {
+	case "MM", "AM":
+		return ""
+	case "P", "M", "QRP", "A", "R", "B", "LH":
+		return first
+	}
+
+	// K1ABC/4 is operating from the 4 call area of the same country.
+	if len(second) == 1 && second[0] >= '0' && second[0] <= '9' {
+		for i := len(first) - 1; i >= 0; i-- {
+			if first[i] >= '0' && first[i] <= '9' {
+				return first[:i] + second
+			}
+		}
+
+		return first
+	}
+
+	if len(first) <= len(second) {
+		return first
+	}
+
+	return second
+}
+
+// LoadCTY reads a table in the cty.dat format of the country files used by
+// most logging programs.
+func LoadCTY(r io.Reader) (*Table, error) {
+	t := &Table{prefixes: make(map[string]Entity), calls: make(map[string]Entity)}
+
+	var (
+		current Entity
+		inside  bool
+		line    int
+	)
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+
+		if text == "" {
+			continue
+		}
+
+		if !inside {
+			e, err := parseCTYHeader(text)
+			if err != nil {
+				return nil, fmt.Errorf("dxcc: line %d: %w", line, err)
+			}
+
+			current, inside = e, true
+
+			continue
+		}
+
+		last := strings.HasSuffix(text, ";")
+		text = strings.TrimSuffix(text, ";")
+
+		for _, p := range strings.Split(text, ",") {
+			if p = strings.TrimSpace(p); p == "" {
+				continue
+			}
+
+			if err := t.addCTYPrefix(p, current); err != nil {
+				return nil, fmt.Errorf("dxcc: line %d: %w", line, err)
+			}
+		}
+
+		inside = !last
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	if inside {
+		return nil, errors.New("dxcc: unterminated prefix list")
+	}
+
+	return t, nil
+}
+
+// parseCTYHeader parses "Name: CQ: ITU: Cont: Lat: Lon: TZ: Prefix:".
+func parseCTYHeader(text string) (Entity, error) {
+	f := strings.Split(text, ":")
+	if len(f) < 8 {
+		return Entity{}, errors.New("invalid entity line")
+	}
+
+	for i := range f {
+		f[i] = strings.TrimSpace(f[i])
+	}
+
+	cq, err := strconv.Atoi(f[1])
+	if err != nil {
+		return Entity{}, fmt.Errorf("invalid CQ zone %q", f[1])
+	}
+
+	itu, err := strconv.Atoi(f[2])
+	if err != nil {
+		return Entity{}, fmt.Errorf("invalid ITU zone %q", f[2])
+	}
+
+	// A leading * marks the entities that only count for the WAE award.
+	return Entity{Name: f[0], Prefix: strings.TrimPrefix(f[7], "*"), Continent: f[3], CQZone: cq, ITUZone: itu}, nil
+}
+
+// addCTYPrefix adds a prefix like "K1(4)[7]", or an exact callsign when it starts with =.
+func (t *Table) addCTYPrefix(p string, e Entity) error {
+	exact := strings.HasPrefix(p, "=")
+	p = strings.TrimPrefix(p, "=")
+
+	end := strings.IndexAny(p, "([<{~")
+	if end < 0 {
+		end = len(p)
+	}
+
+	name, overrides := p[:end], p[end:]
+
+	for overrides != "" {
+		closing := map[byte]byte{'(': ')', '[': ']', '<': '>', '{': '}', '~': '~'}[overrides[0]]
+
+		i := strings.IndexByte(overrides[1:], closing)
+		if closing == 0 || i < 0 {
+			return fmt.Errorf("invalid prefix %q", p)
+		}
+
+		value := overrides[1 : i+1]
+
+		var err error
+
+		switch overrides[0] {
+		case '(':
+			e.CQZone, err = strconv.Atoi(value)
+		case '[':
+			e.ITUZone, err = strconv.Atoi(value)
+		case '{':
+			e.Continent = value
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid prefix %q", p)
+		}
+
+		overrides = overrides[i+2:]
+	}
+
+	if exact {
+		t.calls[name] = e
+	} else {
+		t.addPrefix(name, e)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package dxcc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefault_Lookup(t *testing.T) {
+	tests := []struct {
+		call   string
+		want   string
+		wantOK bool
+	}{
+		{call: "K1ABC", want: "K", wantOK: true},
+		{call: "kh6xyz", want: "KH6", wantOK: true},
+		{call: "EA8ABC", want: "EA8", wantOK: true},
+		{call: "EA1ABC", want: "EA", wantOK: true},
+		{call: "EA8/K1ABC", want: "EA8", wantOK: true},
+		{call: "K1ABC/P", want: "K", wantOK: true},
+		{call: "KH6ABC/2", want: "KH2", wantOK: true},
+		{call: "UA9XYZ", want: "UA9", wantOK: true},
+		{call: "RA3ABC", want: "UA", wantOK: true},
+		{call: "OX3XR", want: "OX", wantOK: true},
+		{call: "IS0ABC", want: "IS0", wantOK: true},
+		{call: "K1ABC/MM", wantOK: false},
+		{call: "QQ1ABC", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.call, func(t *testing.T) {
+			got, ok := Default().Lookup(tt.call)
+			if ok != tt.wantOK || got.Prefix != tt.want {
+				t.Errorf("Lookup(%q) = %q, %v, want %q, %v", tt.call, got.Prefix, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+const cty = `Spain:                    14:  37:  EU:   40.32:     3.43:    -1.0:  EA:
+    AM,AN,AO,EA,EB,EC,ED,EE,EF,EG,EH,=EF1ABC(33)[36]{AF};
+Sov Mil Order of Malta:   15:  28:  EU:   41.90:   -12.43:    -1.0:  1A:
+    1A;
+Alaska:                   01:  01:  NA:   61.40:   148.87:     8.0:  KL:
+    AL,KL,NL,WL,
+    =KC4USV(30)[71];
+`
+
+func TestLoadCTY(t *testing.T) {
+	table, err := LoadCTY(strings.NewReader(cty))
+	if err != nil {
+		t.Fatalf("LoadCTY() error = %v", err)
+	}
+
+	tests := []struct {
+		call string
+		want Entity
+	}{
+		{call: "EA4XYZ", want: Entity{Name: "Spain", Prefix: "EA", Continent: "EU", CQZone: 14, ITUZone: 37}},
+		{call: "EF1ABC", want: Entity{Name: "Spain", Prefix: "EA", Continent: "AF", CQZone: 33, ITUZone: 36}},
+		{call: "1A0KM", want: Entity{Name: "Sov Mil Order of Malta", Prefix: "1A", Continent: "EU", CQZone: 15, ITUZone: 28}},
+		{call: "KC4USV", want: Entity{Name: "Alaska", Prefix: "KL", Continent: "NA", CQZone: 30, ITUZone: 71}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.call, func(t *testing.T) {
+			got, ok := table.Lookup(tt.call)
+			if !ok || got != tt.want {
+				t.Errorf("Lookup(%q) = %+v, %v, want %+v", tt.call, got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, err := LoadCTY(strings.NewReader("Spain: 14: 37: EU: 40: 3: -1: EA:\n    EA,EB,\n")); err == nil {
+		t.Error("LoadCTY() unterminated list: want error")
+	}
+}
@@ -0,0 +1,122 @@
+package dxcluster
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/logocomune/wsjtx/band"
+)
+
+// Filter selects the spots sent to a client. Empty fields match everything.
+type Filter struct {
+	Bands      []string
+	Modes      []string
+	Continents []string
+}
+
+func (f Filter) match(s Spot) bool {
+	return matchAny(f.Bands, s.Band) && matchAny(f.Modes, s.Mode) && matchAny(f.Continents, s.Continent)
+}
+
+func (f Filter) String() string {
+	show := func(l []string) string {
+		if len(l) == 0 {
+			return "all"
+		}
+
+		return strings.Join(l, " ")
+	}
+
+	return fmt.Sprintf("band: %s, mode: %s, continent: %s", show(f.Bands), show(f.Modes), show(f.Continents))
+}
+
+func matchAny(l []string, s string) bool {
+	if len(l) == 0 {
+		return true
+	}
+
+	for _, v := range l {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var continents = map[string]bool{"AF": true, "AN": true, "AS": true, "EU": true, "NA": true, "OC": true, "SA": true}
+
+// command runs a command line and returns the reply, and whether the client quits.
+func (c *client) command(line string) (string, bool) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	cmd, args := strings.ToLower(fields[0]), fields[1:]
+
+	switch cmd {
+	case "bye", "quit", "exit", "q":
+		return "73 de " + c.call, true
+	case "show/filter", "sh/filter":
+		return c.getFilter().String(), false
+	case "set/band", "set/mode", "set/continent":
+		values, reply := filterValues(cmd, args)
+		if reply != "" {
+			return reply, false
+		}
+
+		c.mu.Lock()
+		switch cmd {
+		case "set/band":
+			c.filter.Bands = values
+		case "set/mode":
+			c.filter.Modes = values
+		case "set/continent":
+			c.filter.Continents = values
+		}
+		f := c.filter
+		c.mu.Unlock()
+
+		return f.String(), false
+	case "help", "?":
+		return "Commands: set/band <bands|all>, set/mode <modes|all>, set/continent <continents|all>, show/filter, bye", false
+	default:
+		return "Unknown command: " + fields[0], false
+	}
+}
+
+// filterValues checks the arguments of a set command, returning the reply
+// to the client when they are invalid; "all" clears the filter.
+func filterValues(cmd string, args []string) ([]string, string) {
+	if len(args) == 0 {
+		return nil, "Usage: " + cmd + " <values|all>"
+	}
+
+	if len(args) == 1 && strings.EqualFold(args[0], "all") {
+		return nil, ""
+	}
+
+	values := make([]string, 0, len(args))
+
+	for _, a := range args {
+		switch cmd {
+		case "set/band":
+			a = strings.ToLower(a)
+			if !band.Valid(a) {
+				return nil, "Unknown band: " + a
+			}
+		case "set/continent":
+			a = strings.ToUpper(a)
+			if !continents[a] {
+				return nil, "Unknown continent: " + a
+			}
+		default:
+			a = strings.ToUpper(a)
+		}
+
+		values = append(values, a)
+	}
+
+	return values, ""
+}
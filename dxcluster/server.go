@@ -0,0 +1,490 @@
+// Package dxcluster serves the CQ decodes as DX-cluster spots to telnet
+// clients, like the logging programs N1MM or Log4OM.
+//
+// Clients log in with their callsign and receive lines like
+//
+//	DX de K1ABC-#:   14075.5  W9XYZ        FT8 -12 dB EN37                1045Z
+//
+// They can filter the spots with these commands:
+//
+//	set/band 20m 40m      only the spots on these bands; "set/band all" to reset
+//	set/mode FT8          only the spots in these modes
+//	set/continent EU NA   only the stations of these continents
+//	show/filter           the current filters
+//	bye                   disconnect
+package dxcluster
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/dxcc"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultDedupe is how long a callsign is spotted once per band.
+	DefaultDedupe = 10 * time.Minute
+	// DefaultName is the node name of the greeting and prompt.
+	DefaultName = "WSJTX"
+
+	clientQueue  = 64
+	writeTimeout = 10 * time.Second
+	loginTimeout = time.Minute
+	maxLine      = 256
+)
+
+// Spot is a station heard calling CQ.
+type Spot struct {
+	// Spotter is the callsign of the instance operator.
+	Spotter     string
+	Call        string
+	FrequencyHz uint64
+	Band        string
+	Mode        string
+	SNR         int
+	Grid        string
+	Continent   string
+	Time        time.Time
+}
+
+// String formats the spot as a DX-cluster line, without the line ending.
+func (s Spot) String() string {
+	comment := fmt.Sprintf("%s %d dB %s", s.Mode, s.SNR, s.Grid)
+
+	return fmt.Sprintf("DX de %-9s %8.1f  %-12s %-30s %sZ",
+		s.Spotter+"-#:", float64(s.FrequencyHz)/1000, s.Call, strings.TrimSpace(comment), s.Time.UTC().Format("1504"))
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type station struct {
+	myCall string
+	dial   uint64
+	mode   string
+}
+
+type dedupeKey struct {
+	call string
+	band string
+}
+
+// Server accepts the telnet clients and sends them the spots.
+type Server struct {
+	log      logger
+	name     string
+	dedupe   time.Duration
+	table    *dxcc.Table
+	mu       sync.Mutex
+	stations map[string]station
+	spotted  map[dedupeKey]time.Time
+	clients  map[*client]struct{}
+	closed   bool
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithDedupe sets how long a callsign is spotted once per band.
+func WithDedupe(d time.Duration) Option {
+	return func(s *Server) {
+		if d >= 0 {
+			s.dedupe = d
+		}
+	}
+}
+
+// WithName sets the node name of the greeting and prompt.
+func WithName(name string) Option {
+	return func(s *Server) {
+		s.name = name
+	}
+}
+
+// WithTable sets the DXCC table giving the continents, dxcc.Default() by default.
+func WithTable(t *dxcc.Table) Option {
+	return func(s *Server) {
+		s.table = t
+	}
+}
+
+func NewServer(logger logger, opts ...Option) *Server {
+	s := &Server{
+		log:      logger,
+		name:     DefaultName,
+		dedupe:   DefaultDedupe,
+		table:    dxcc.Default(),
+		stations: make(map[string]station),
+		spotted:  make(map[dedupeKey]time.Time),
+		clients:  make(map[*client]struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Register feeds the server with the messages dispatched by router.
+func (s *Server) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, st message.StatusResponse) {
+		s.Status(st)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		s.Decode(m.Received, d)
+	})
+}
+
+// Status records the operator, dial frequency and mode of the instance.
+func (s *Server) Status(st message.StatusResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stations[st.ID] = station{myCall: st.DECall, dial: st.Dial, mode: st.Mode}
+}
+
+// Decode spots the stations calling CQ. Replays and decodes of instances
+// without a Status are ignored.
+func (s *Server) Decode(at time.Time, d message.DecodeResponse) {
+	if !d.New || d.OffAir {
+		return
+	}
+
+	s.mu.Lock()
+	st, ok := s.stations[d.ID]
+	s.mu.Unlock()
+
+	if !ok || st.myCall == "" {
+		return
+	}
+
+	m := msgtext.Parse(d.Message)
+	if m.Kind != msgtext.CQ {
+		return
+	}
+
+	freq := st.dial + uint64(d.DeltaFrequencyHz)
+	spot := Spot{
+		Spotter:     st.myCall,
+		Call:        m.From,
+		FrequencyHz: freq,
+		Band:        band.FromFrequency(freq),
+		Mode:        st.mode,
+		SNR:         int(d.SNR),
+		Grid:        m.Grid,
+		Time:        at,
+	}
+
+	if e, ok := s.table.Lookup(m.From); ok {
+		spot.Continent = e.Continent
+	}
+
+	s.Spot(spot)
+}
+
+// Spot sends spot to the clients whose filters match it, unless the callsign
+// was spotted on the band within the dedupe window.
+func (s *Server) Spot(spot Spot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, at := range s.spotted {
+		if spot.Time.Sub(at) >= s.dedupe {
+			delete(s.spotted, k)
+		}
+	}
+
+	k := dedupeKey{call: spot.Call, band: spot.Band}
+	if _, ok := s.spotted[k]; ok {
+		return
+	}
+
+	s.spotted[k] = spot.Time
+	line := spot.String() + "\r\n"
+
+	for c := range s.clients {
+		if c.getFilter().match(spot) {
+			c.send(line)
+		}
+	}
+}
+
+// ListenAndServe listens on the TCP address addr and serves the clients until ctx is done.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		l.Close()
+		s.Close()
+	}()
+
+	err = s.Serve(l)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
+
+// Serve accepts the clients of l until it is closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.serve(conn)
+	}
+}
+
+// Close disconnects all the clients.
+func (s *Server) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	for c := range s.clients {
+		c.close()
+		delete(s.clients, c)
+	}
+}
+
+// Clients returns the callsigns of the clients logged in.
+func (s *Server) Clients() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	calls := make([]string, 0, len(s.clients))
+	for c := range s.clients {
+		calls = append(calls, c.call)
+	}
+
+	return calls
+}
+
+func (s *Server) serve(conn net.Conn) {
+	c := &client{conn: conn, out: make(chan string, clientQueue), done: make(chan struct{})}
+	defer c.close()
+
+	r := bufio.NewReaderSize(conn, maxLine)
+
+	go c.writer(s.log)
+
+	c.send("Please enter your call: ")
+
+	_ = conn.SetReadDeadline(time.Now().Add(loginTimeout))
+
+	line, err := readLine(r)
+	if err != nil {
+		return
+	}
+
+	call := strings.ToUpper(strings.TrimSpace(line))
+	if !msgtext.IsCallsign(strings.SplitN(call, "-", 2)[0]) {
+		c.send("Invalid callsign\r\n")
+		c.flush()
+
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Time{})
+	c.call = call
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+
+		return
+	}
+	s.clients[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+	}()
+
+	c.send(fmt.Sprintf("Hello %s, this is %s, spotting the local WSJT-X decodes.\r\n", call, s.name))
+	c.send(s.prompt(c))
+
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			return
+		}
+
+		reply, quit := c.command(line)
+		if reply != "" {
+			c.send(reply + "\r\n")
+		}
+
+		if quit {
+			c.flush()
+
+			return
+		}
+
+		c.send(s.prompt(c))
+	}
+}
+
+func (s *Server) prompt(c *client) string {
+	return fmt.Sprintf("%s de %s >\r\n", c.call, s.name)
+}
+
+// readLine reads a line, dropping the telnet negotiations.
+func readLine(r *bufio.Reader) (string, error) {
+	var b strings.Builder
+
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case c == iac:
+			if err := skipCommand(r); err != nil {
+				return "", err
+			}
+		case c == '\n':
+			return strings.TrimRight(b.String(), "\r"), nil
+		case c == 0:
+		default:
+			if b.Len() >= maxLine {
+				return "", errLineTooLong
+			}
+
+			b.WriteByte(c)
+		}
+	}
+}
+
+const (
+	iac = 255
+	sb  = 250
+	se  = 240
+)
+
+var errLineTooLong = errors.New("dxcluster: line too long")
+
+// skipCommand skips a telnet command after IAC: an option negotiation or a subnegotiation.
+func skipCommand(r *bufio.Reader) error {
+	cmd, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case cmd == sb:
+		for prev := byte(0); ; {
+			c, err := r.ReadByte()
+			if err != nil {
+				return err
+			}
+
+			if prev == iac && c == se {
+				return nil
+			}
+
+			prev = c
+		}
+	case cmd >= 251 && cmd <= 254:
+		_, err = r.ReadByte()
+
+		return err
+	default:
+		return nil
+	}
+}
+
+type client struct {
+	conn   net.Conn
+	call   string
+	out    chan string
+	done   chan struct{}
+	once   sync.Once
+	mu     sync.Mutex
+	filter Filter
+}
+
+func (c *client) getFilter() Filter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.filter
+}
+
+// send queues line, disconnecting the client when it does not keep up.
+func (c *client) send(line string) {
+	select {
+	case c.out <- line:
+	case <-c.done:
+	default:
+		c.close()
+	}
+}
+
+// flush waits for the queued lines to be written, before a disconnection.
+func (c *client) flush() {
+	select {
+	case c.out <- "":
+	case <-c.done:
+		return
+	}
+
+	select {
+	case <-c.done:
+	case <-time.After(writeTimeout):
+	}
+}
+
+func (c *client) writer(log logger) {
+	for {
+		select {
+		case <-c.done:
+			return
+		case line := <-c.out:
+			// The empty line queued by flush closes the connection.
+			if line == "" {
+				c.close()
+
+				return
+			}
+
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if _, err := c.conn.Write([]byte(line)); err != nil {
+				log.Println("dxcluster: write:", err)
+				c.close()
+
+				return
+			}
+		}
+	}
+}
+
+func (c *client) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
@@ -0,0 +1,233 @@
+package dxcluster
+
+import (
+	"bufio"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestSpotString(t *testing.T) {
+	s := Spot{
+		Spotter:     "K1ABC",
+		Call:        "W9XYZ",
+		FrequencyHz: 14075500,
+		Mode:        "FT8",
+		SNR:         -12,
+		Grid:        "EN37",
+		Time:        time.Date(2022, 2, 4, 10, 45, 30, 0, time.UTC),
+	}
+
+	want := "DX de K1ABC-#:   14075.5  W9XYZ        FT8 -12 dB EN37                1045Z"
+	if got := s.String(); got != want {
+		t.Errorf("String() =\n%q, want\n%q", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	spot := Spot{Band: "20m", Mode: "FT8", Continent: "NA"}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"band", Filter{Bands: []string{"40m", "20m"}}, true},
+		{"other band", Filter{Bands: []string{"40m"}}, false},
+		{"mode", Filter{Modes: []string{"ft8"}}, true},
+		{"other mode", Filter{Modes: []string{"FT4"}}, false},
+		{"continent", Filter{Continents: []string{"EU"}}, false},
+		{"all", Filter{Bands: []string{"20m"}, Modes: []string{"FT8"}, Continents: []string{"NA"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(spot); got != tt.want {
+				t.Errorf("match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommand(t *testing.T) {
+	c := &client{call: "N0CALL"}
+
+	tests := []struct {
+		line  string
+		reply string
+		quit  bool
+	}{
+		{"set/band 20m 40M", "band: 20m 40m, mode: all, continent: all", false},
+		{"set/band 11m", "Unknown band: 11m", false},
+		{"SET/MODE ft8", "band: 20m 40m, mode: FT8, continent: all", false},
+		{"set/continent eu xx", "Unknown continent: XX", false},
+		{"set/continent eu", "band: 20m 40m, mode: FT8, continent: EU", false},
+		{"set/band all", "band: all, mode: FT8, continent: EU", false},
+		{"set/mode", "Usage: set/mode <values|all>", false},
+		{"show/filter", "band: all, mode: FT8, continent: EU", false},
+		{"sh/dx", "Unknown command: sh/dx", false},
+		{"", "", false},
+		{"bye", "73 de N0CALL", true},
+	}
+
+	for _, tt := range tests {
+		reply, quit := c.command(tt.line)
+		if reply != tt.reply || quit != tt.quit {
+			t.Errorf("command(%q) = %q, %v, want %q, %v", tt.line, reply, quit, tt.reply, tt.quit)
+		}
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("\xff\xfb\x01K1\xff\xfa\x18\x00xterm\xff\xf0ABC\r\n"))
+
+	line, err := readLine(r)
+	if err != nil || line != "K1ABC" {
+		t.Errorf("readLine() = %q, %v, want K1ABC", line, err)
+	}
+}
+
+type telnet struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func dial(t *testing.T, addr string) *telnet {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	return &telnet{t: t, conn: conn, r: bufio.NewReader(conn)}
+}
+
+// expect reads until a line starting with prefix.
+func (c *telnet) expect(prefix string) string {
+	c.t.Helper()
+
+	for {
+		line, err := c.r.ReadString('\n')
+		if err != nil {
+			c.t.Fatalf("waiting for %q: %v", prefix, err)
+		}
+
+		if line = strings.TrimRight(line, "\r\n"); strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+}
+
+// prompt reads the login prompt, which has no line ending.
+func (c *telnet) prompt() {
+	c.t.Helper()
+
+	prompt := make([]byte, len("Please enter your call: "))
+	if _, err := io.ReadFull(c.r, prompt); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *telnet) login(call string) {
+	c.t.Helper()
+
+	c.prompt()
+	c.send(call)
+	c.expect("Hello " + call)
+	c.expect(call + " de WSJTX >")
+}
+
+func (c *telnet) send(line string) {
+	if _, err := c.conn.Write([]byte(line + "\r\n")); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func waitClients(t *testing.T, s *Server, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(s.Clients()) != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Clients() = %v, want %d clients", s.Clients(), n)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewServer(log.New(io.Discard, "", 0))
+	defer s.Close()
+
+	go s.Serve(l)
+	defer l.Close()
+
+	bad := dial(t, l.Addr().String())
+	bad.prompt()
+	bad.send("not a call")
+	bad.expect("Invalid callsign")
+
+	if _, err := bad.r.ReadByte(); err != io.EOF {
+		t.Errorf("invalid login not disconnected: %v", err)
+	}
+
+	all := dial(t, l.Addr().String())
+	all.login("N0CALL")
+
+	eu := dial(t, l.Addr().String())
+	eu.login("G4ABC")
+	eu.send("set/continent EU")
+	eu.expect("band: all, mode: all, continent: EU")
+
+	waitClients(t, s, 2)
+
+	now := time.Date(2022, 2, 4, 10, 45, 0, 0, time.UTC)
+	decode := func(text string, isNew bool) {
+		s.Decode(now, message.DecodeResponse{ID: "WSJT-X", New: isNew, SNR: -12, DeltaFrequencyHz: 1500, Message: text})
+	}
+
+	decode("CQ W9XYZ EN37", true)
+	s.Status(message.StatusResponse{ID: "WSJT-X", DECall: "K1ABC", Dial: 14074000, Mode: "FT8"})
+	decode("W9XYZ K1ABC -10", true)
+	decode("CQ JA1XYZ PM95", false)
+	decode("CQ W9XYZ EN37", true)
+	decode("CQ W9XYZ EN37", true)
+	decode("CQ DX F5ABC JN18", true)
+
+	if got, want := all.expect("DX de"), "DX de K1ABC-#:   14075.5  W9XYZ        FT8 -12 dB EN37                1045Z"; got != want {
+		t.Errorf("spot =\n%q, want\n%q", got, want)
+	}
+
+	if got := all.expect("DX de"); !strings.Contains(got, "F5ABC") {
+		t.Errorf("spot = %q, want F5ABC", got)
+	}
+
+	if got := eu.expect("DX de"); !strings.Contains(got, "F5ABC") {
+		t.Errorf("filtered spot = %q, want F5ABC", got)
+	}
+
+	all.send("bye")
+	all.expect("73 de N0CALL")
+
+	if _, err := all.r.ReadByte(); err != io.EOF {
+		t.Errorf("bye not disconnected: %v", err)
+	}
+
+	waitClients(t, s, 1)
+}
@@ -2,6 +2,20 @@ module github.com/logocomune/wsjtx
 
 go 1.17
 
-require github.com/soniakeys/meeus/v3 v3.0.1
+require (
+	github.com/eclipse/paho.mqtt.golang v1.4.3
+	github.com/gorilla/websocket v1.5.0
+	github.com/soniakeys/meeus/v3 v3.0.1
+	google.golang.org/grpc v1.43.0
+	google.golang.org/protobuf v1.27.1
+)
 
-require github.com/soniakeys/unit v1.0.0 // indirect
+require (
+	github.com/golang/protobuf v1.5.0 // indirect
+	github.com/soniakeys/unit v1.0.0 // indirect
+	golang.org/x/net v0.8.0 // indirect
+	golang.org/x/sync v0.1.0 // indirect
+	golang.org/x/sys v0.6.0 // indirect
+	golang.org/x/text v0.8.0 // indirect
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013 // indirect
+)
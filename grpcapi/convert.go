@@ -0,0 +1,709 @@
+package grpcapi
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/logocomune/wsjtx/grpcapi/wsjtxpb"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+// The conversions are lossless: times are kept to the nanosecond, in UTC, and the
+// zero time is an unset timestamp. Conversions from protobuf to the narrower
+// fields of the message package fail when the value does not fit.
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func toUint8(field string, v uint32) (uint8, error) {
+	if v > math.MaxUint8 {
+		return 0, fmt.Errorf("grpcapi: %s: %d out of range", field, v)
+	}
+
+	return uint8(v), nil
+}
+
+func toUint16(field string, v uint32) (uint16, error) {
+	if v > math.MaxUint16 {
+		return 0, fmt.Errorf("grpcapi: %s: %d out of range", field, v)
+	}
+
+	return uint16(v), nil
+}
+
+// MessageToProto converts a message received by a udpserver.
+func MessageToProto(m udpserver.Message) (*wsjtxpb.Message, error) {
+	pb := &wsjtxpb.Message{
+		Type:     m.ResponseType,
+		Instance: m.InstanceID(),
+		Received: toTimestamp(m.Received),
+	}
+
+	if m.Addr != nil {
+		pb.Addr = m.Addr.String()
+	}
+
+	switch msg := m.Message.(type) {
+	case message.HeartbeatResponse:
+		pb.Payload = &wsjtxpb.Message_Heartbeat{Heartbeat: HeartbeatResponseToProto(msg)}
+	case message.StatusResponse:
+		pb.Payload = &wsjtxpb.Message_Status{Status: StatusResponseToProto(msg)}
+	case message.DecodeResponse:
+		pb.Payload = &wsjtxpb.Message_Decode{Decode: DecodeResponseToProto(msg)}
+	case message.ClearResponse:
+		pb.Payload = &wsjtxpb.Message_Clear{Clear: ClearResponseToProto(msg)}
+	case message.QSOLoggedResponse:
+		pb.Payload = &wsjtxpb.Message_QsoLogged{QsoLogged: QSOLoggedResponseToProto(msg)}
+	case message.CloseResponse:
+		pb.Payload = &wsjtxpb.Message_Close{Close: CloseResponseToProto(msg)}
+	case message.WSPRDecodeResponse:
+		pb.Payload = &wsjtxpb.Message_WsprDecode{WsprDecode: WSPRDecodeResponseToProto(msg)}
+	case message.LoggedADIFResponse:
+		pb.Payload = &wsjtxpb.Message_LoggedAdif{LoggedAdif: LoggedADIFResponseToProto(msg)}
+	default:
+		return nil, fmt.Errorf("grpcapi: unsupported message %T", m.Message)
+	}
+
+	return pb, nil
+}
+
+// MessageFromProto converts back a message returned by MessageToProto.
+func MessageFromProto(pb *wsjtxpb.Message) (udpserver.Message, error) {
+	m := udpserver.Message{Received: fromTimestamp(pb.GetReceived())}
+
+	if pb.GetAddr() != "" {
+		addr, err := net.ResolveUDPAddr("udp", pb.GetAddr())
+		if err != nil {
+			return udpserver.Message{}, err
+		}
+
+		m.Addr = addr
+	}
+
+	switch p := pb.GetPayload().(type) {
+	case *wsjtxpb.Message_Heartbeat:
+		m.Response = message.Response{ResponseType: message.HeartbeatType, Message: HeartbeatResponseFromProto(p.Heartbeat)}
+	case *wsjtxpb.Message_Status:
+		m.Response = message.Response{ResponseType: message.StatusType, Message: StatusResponseFromProto(p.Status)}
+	case *wsjtxpb.Message_Decode:
+		m.Response = message.Response{ResponseType: message.DecodeType, Message: DecodeResponseFromProto(p.Decode)}
+	case *wsjtxpb.Message_Clear:
+		c, err := ClearResponseFromProto(p.Clear)
+		if err != nil {
+			return udpserver.Message{}, err
+		}
+
+		m.Response = message.Response{ResponseType: message.ClearType, Message: c}
+	case *wsjtxpb.Message_QsoLogged:
+		m.Response = message.Response{ResponseType: message.QSOLoggedType, Message: QSOLoggedResponseFromProto(p.QsoLogged)}
+	case *wsjtxpb.Message_Close:
+		m.Response = message.Response{ResponseType: message.CloseType, Message: CloseResponseFromProto(p.Close)}
+	case *wsjtxpb.Message_WsprDecode:
+		m.Response = message.Response{ResponseType: message.WSPRDecodeType, Message: WSPRDecodeResponseFromProto(p.WsprDecode)}
+	case *wsjtxpb.Message_LoggedAdif:
+		m.Response = message.Response{ResponseType: message.LoggedADIFType, Message: LoggedADIFResponseFromProto(p.LoggedAdif)}
+	default:
+		return udpserver.Message{}, fmt.Errorf("grpcapi: message without payload")
+	}
+
+	return m, nil
+}
+
+func HeartbeatResponseToProto(h message.HeartbeatResponse) *wsjtxpb.HeartbeatResponse {
+	return &wsjtxpb.HeartbeatResponse{
+		Id:              h.ID,
+		MaxSchemaNumber: h.MaxSchemaNumber,
+		Version:         h.Version,
+		Revision:        h.Revision,
+	}
+}
+
+func HeartbeatResponseFromProto(pb *wsjtxpb.HeartbeatResponse) message.HeartbeatResponse {
+	return message.HeartbeatResponse{
+		ID:              pb.GetId(),
+		MaxSchemaNumber: pb.GetMaxSchemaNumber(),
+		Version:         pb.GetVersion(),
+		Revision:        pb.GetRevision(),
+	}
+}
+
+func StatusResponseToProto(s message.StatusResponse) *wsjtxpb.StatusResponse {
+	return &wsjtxpb.StatusResponse{
+		Id:                   s.ID,
+		Dial:                 s.Dial,
+		Mode:                 s.Mode,
+		DxCall:               s.DXCall,
+		Report:               s.Report,
+		TxMode:               s.TXMode,
+		TxEnabled:            s.TXEnabled,
+		Transmitting:         s.Transmitting,
+		Decoding:             s.Decoding,
+		RxDf:                 s.RXDF,
+		TxDf:                 s.TXDF,
+		DeCall:               s.DECall,
+		DeGrid:               s.DEGrid,
+		DxGrid:               s.DXGrid,
+		TxWatchdog:           s.TXWatchdog,
+		SubMode:              s.SUBMode,
+		FastMode:             s.FastMode,
+		SpecialOperationMode: s.SpecialOperationMode,
+		FrequencyTolerance:   s.FrequencyTolerance,
+		TrPeriod:             s.TRPeriod,
+		ConfigurationName:    s.ConfigurationName,
+		TxMessage:            s.TXMessage,
+	}
+}
+
+func StatusResponseFromProto(pb *wsjtxpb.StatusResponse) message.StatusResponse {
+	return message.StatusResponse{
+		ID:                   pb.GetId(),
+		Dial:                 pb.GetDial(),
+		Mode:                 pb.GetMode(),
+		DXCall:               pb.GetDxCall(),
+		Report:               pb.GetReport(),
+		TXMode:               pb.GetTxMode(),
+		TXEnabled:            pb.GetTxEnabled(),
+		Transmitting:         pb.GetTransmitting(),
+		Decoding:             pb.GetDecoding(),
+		RXDF:                 pb.GetRxDf(),
+		TXDF:                 pb.GetTxDf(),
+		DECall:               pb.GetDeCall(),
+		DEGrid:               pb.GetDeGrid(),
+		DXGrid:               pb.GetDxGrid(),
+		TXWatchdog:           pb.GetTxWatchdog(),
+		SUBMode:              pb.GetSubMode(),
+		FastMode:             pb.GetFastMode(),
+		SpecialOperationMode: pb.GetSpecialOperationMode(),
+		FrequencyTolerance:   pb.GetFrequencyTolerance(),
+		TRPeriod:             pb.GetTrPeriod(),
+		ConfigurationName:    pb.GetConfigurationName(),
+		TXMessage:            pb.GetTxMessage(),
+	}
+}
+
+func DecodeResponseToProto(d message.DecodeResponse) *wsjtxpb.DecodeResponse {
+	return &wsjtxpb.DecodeResponse{
+		Id:               d.ID,
+		New:              d.New,
+		Time:             d.Time,
+		FullTime:         toTimestamp(d.FullTime),
+		Snr:              d.SNR,
+		DeltaTime:        d.DeltaTime,
+		DeltaFrequencyHz: d.DeltaFrequencyHz,
+		Mode:             d.Mode,
+		Message:          d.Message,
+		LowConfidence:    d.LowConfidence,
+		OffAir:           d.OffAir,
+	}
+}
+
+func DecodeResponseFromProto(pb *wsjtxpb.DecodeResponse) message.DecodeResponse {
+	return message.DecodeResponse{
+		ID:               pb.GetId(),
+		New:              pb.GetNew(),
+		Time:             pb.GetTime(),
+		FullTime:         fromTimestamp(pb.GetFullTime()),
+		SNR:              pb.GetSnr(),
+		DeltaTime:        pb.GetDeltaTime(),
+		DeltaFrequencyHz: pb.GetDeltaFrequencyHz(),
+		Mode:             pb.GetMode(),
+		Message:          pb.GetMessage(),
+		LowConfidence:    pb.GetLowConfidence(),
+		OffAir:           pb.GetOffAir(),
+	}
+}
+
+func ClearResponseToProto(c message.ClearResponse) *wsjtxpb.ClearResponse {
+	return &wsjtxpb.ClearResponse{Id: c.ID, Windows: uint32(c.Windows)}
+}
+
+func ClearResponseFromProto(pb *wsjtxpb.ClearResponse) (message.ClearResponse, error) {
+	windows, err := toUint8("windows", pb.GetWindows())
+
+	return message.ClearResponse{ID: pb.GetId(), Windows: windows}, err
+}
+
+func QSOLoggedResponseToProto(q message.QSOLoggedResponse) *wsjtxpb.QSOLoggedResponse {
+	return &wsjtxpb.QSOLoggedResponse{
+		Id:                  q.ID,
+		DateAndTimeOff:      toTimestamp(q.DateAndTimeOff),
+		DxCall:              q.DXCall,
+		DxGrid:              q.DXGrid,
+		TxFrequencyHz:       q.TXFrequencyHz,
+		Mode:                q.Mode,
+		ReportSent:          q.ReportSent,
+		ReportReceived:      q.ReportReceived,
+		TxPower:             q.TXPower,
+		Comments:            q.Comments,
+		Name:                q.Name,
+		DateAndTimeOn:       toTimestamp(q.DateAndTimeOn),
+		OperatorCall:        q.OperatorCall,
+		MyCall:              q.MyCall,
+		MyGrid:              q.MyGrid,
+		ExchangeSent:        q.ExchangeSent,
+		ExchangeReceived:    q.ExchangeReceived,
+		AdifPropagationMode: q.ADIFPropagationMode,
+	}
+}
+
+func QSOLoggedResponseFromProto(pb *wsjtxpb.QSOLoggedResponse) message.QSOLoggedResponse {
+	return message.QSOLoggedResponse{
+		ID:                  pb.GetId(),
+		DateAndTimeOff:      fromTimestamp(pb.GetDateAndTimeOff()),
+		DXCall:              pb.GetDxCall(),
+		DXGrid:              pb.GetDxGrid(),
+		TXFrequencyHz:       pb.GetTxFrequencyHz(),
+		Mode:                pb.GetMode(),
+		ReportSent:          pb.GetReportSent(),
+		ReportReceived:      pb.GetReportReceived(),
+		TXPower:             pb.GetTxPower(),
+		Comments:            pb.GetComments(),
+		Name:                pb.GetName(),
+		DateAndTimeOn:       fromTimestamp(pb.GetDateAndTimeOn()),
+		OperatorCall:        pb.GetOperatorCall(),
+		MyCall:              pb.GetMyCall(),
+		MyGrid:              pb.GetMyGrid(),
+		ExchangeSent:        pb.GetExchangeSent(),
+		ExchangeReceived:    pb.GetExchangeReceived(),
+		ADIFPropagationMode: pb.GetAdifPropagationMode(),
+	}
+}
+
+func CloseResponseToProto(c message.CloseResponse) *wsjtxpb.CloseResponse {
+	return &wsjtxpb.CloseResponse{Id: c.ID}
+}
+
+func CloseResponseFromProto(pb *wsjtxpb.CloseResponse) message.CloseResponse {
+	return message.CloseResponse{ID: pb.GetId()}
+}
+
+func WSPRDecodeResponseToProto(w message.WSPRDecodeResponse) *wsjtxpb.WSPRDecodeResponse {
+	return &wsjtxpb.WSPRDecodeResponse{
+		Id:          w.ID,
+		New:         w.New,
+		Time:        w.Time,
+		FullTime:    toTimestamp(w.FullTime),
+		Snr:         w.SNR,
+		DeltaTime:   w.DeltaTime,
+		FrequencyHz: w.FrequencyHz,
+		DriftHz:     w.DriftHz,
+		Callsign:    w.Callsign,
+		Grid:        w.Grid,
+		PowerDbm:    w.PowerdBm,
+		PowerWatt:   w.PowerWatt,
+		OffAir:      w.OffAir,
+	}
+}
+
+func WSPRDecodeResponseFromProto(pb *wsjtxpb.WSPRDecodeResponse) message.WSPRDecodeResponse {
+	return message.WSPRDecodeResponse{
+		ID:          pb.GetId(),
+		New:         pb.GetNew(),
+		Time:        pb.GetTime(),
+		FullTime:    fromTimestamp(pb.GetFullTime()),
+		SNR:         pb.GetSnr(),
+		DeltaTime:   pb.GetDeltaTime(),
+		FrequencyHz: pb.GetFrequencyHz(),
+		DriftHz:     pb.GetDriftHz(),
+		Callsign:    pb.GetCallsign(),
+		Grid:        pb.GetGrid(),
+		PowerdBm:    pb.GetPowerDbm(),
+		PowerWatt:   pb.GetPowerWatt(),
+		OffAir:      pb.GetOffAir(),
+	}
+}
+
+func LoggedADIFResponseToProto(l message.LoggedADIFResponse) *wsjtxpb.LoggedADIFResponse {
+	return &wsjtxpb.LoggedADIFResponse{Id: l.ID, Adif: l.ADIF}
+}
+
+func LoggedADIFResponseFromProto(pb *wsjtxpb.LoggedADIFResponse) message.LoggedADIFResponse {
+	return message.LoggedADIFResponse{ID: pb.GetId(), ADIF: pb.GetAdif()}
+}
+
+func HeartbeatMessageToProto(h message.HeartbeatMessage) *wsjtxpb.HeartbeatMessage {
+	return &wsjtxpb.HeartbeatMessage{
+		Id:              h.ID,
+		MaxSchemaNumber: h.MaxSchemaNumber,
+		Version:         h.Version,
+		Revision:        h.Revision,
+	}
+}
+
+func HeartbeatMessageFromProto(pb *wsjtxpb.HeartbeatMessage) message.HeartbeatMessage {
+	return message.HeartbeatMessage{
+		ID:              pb.GetId(),
+		MaxSchemaNumber: pb.GetMaxSchemaNumber(),
+		Version:         pb.GetVersion(),
+		Revision:        pb.GetRevision(),
+	}
+}
+
+func StatusMessageToProto(s message.StatusMessage) *wsjtxpb.StatusMessage {
+	return &wsjtxpb.StatusMessage{
+		Id:                   s.ID,
+		Dial:                 s.Dial,
+		Mode:                 s.Mode,
+		DxCall:               s.DXCall,
+		Report:               s.Report,
+		TxMode:               s.TXMode,
+		TxEnabled:            s.TXEnabled,
+		Transmitting:         s.Transmitting,
+		Decoding:             s.Decoding,
+		RxDf:                 s.RXDF,
+		TxDf:                 s.TXDF,
+		DeCall:               s.DECall,
+		DeGrid:               s.DEGrid,
+		DxGrid:               s.DXGrid,
+		TxWatchdog:           s.TXWatchdog,
+		SubMode:              s.SUBMode,
+		FastMode:             s.FastMode,
+		SpecialOperationMode: s.SpecialOperationMode,
+		FrequencyTolerance:   s.FrequencyTolerance,
+		TrPeriod:             s.TRPeriod,
+		ConfigurationName:    s.ConfigurationName,
+		TxMessage:            s.TXMessage,
+	}
+}
+
+func StatusMessageFromProto(pb *wsjtxpb.StatusMessage) message.StatusMessage {
+	return message.StatusMessage{
+		ID:                   pb.GetId(),
+		Dial:                 pb.GetDial(),
+		Mode:                 pb.GetMode(),
+		DXCall:               pb.GetDxCall(),
+		Report:               pb.GetReport(),
+		TXMode:               pb.GetTxMode(),
+		TXEnabled:            pb.GetTxEnabled(),
+		Transmitting:         pb.GetTransmitting(),
+		Decoding:             pb.GetDecoding(),
+		RXDF:                 pb.GetRxDf(),
+		TXDF:                 pb.GetTxDf(),
+		DECall:               pb.GetDeCall(),
+		DEGrid:               pb.GetDeGrid(),
+		DXGrid:               pb.GetDxGrid(),
+		TXWatchdog:           pb.GetTxWatchdog(),
+		SUBMode:              pb.GetSubMode(),
+		FastMode:             pb.GetFastMode(),
+		SpecialOperationMode: pb.GetSpecialOperationMode(),
+		FrequencyTolerance:   pb.GetFrequencyTolerance(),
+		TRPeriod:             pb.GetTrPeriod(),
+		ConfigurationName:    pb.GetConfigurationName(),
+		TXMessage:            pb.GetTxMessage(),
+	}
+}
+
+func DecodeMessageToProto(d message.DecodeMessage) *wsjtxpb.DecodeMessage {
+	return &wsjtxpb.DecodeMessage{
+		Id:               d.ID,
+		New:              d.New,
+		Time:             d.Time,
+		FullTime:         toTimestamp(d.FullTime),
+		Snr:              d.SNR,
+		DeltaTime:        d.DeltaTime,
+		DeltaFrequencyHz: d.DeltaFrequencyHZ,
+		Mode:             d.Mode,
+		Message:          d.Message,
+		LowConfidence:    d.LowConfidence,
+		OffAir:           d.OffAir,
+	}
+}
+
+func DecodeMessageFromProto(pb *wsjtxpb.DecodeMessage) message.DecodeMessage {
+	return message.DecodeMessage{
+		ID:               pb.GetId(),
+		New:              pb.GetNew(),
+		Time:             pb.GetTime(),
+		FullTime:         fromTimestamp(pb.GetFullTime()),
+		SNR:              pb.GetSnr(),
+		DeltaTime:        pb.GetDeltaTime(),
+		DeltaFrequencyHZ: pb.GetDeltaFrequencyHz(),
+		Mode:             pb.GetMode(),
+		Message:          pb.GetMessage(),
+		LowConfidence:    pb.GetLowConfidence(),
+		OffAir:           pb.GetOffAir(),
+	}
+}
+
+func ClearMessageToProto(c message.ClearMessage) *wsjtxpb.ClearMessage {
+	return &wsjtxpb.ClearMessage{Id: c.ID, Windows: uint32(c.Windows)}
+}
+
+func ClearMessageFromProto(pb *wsjtxpb.ClearMessage) (message.ClearMessage, error) {
+	windows, err := toUint8("windows", pb.GetWindows())
+
+	return message.ClearMessage{ID: pb.GetId(), Windows: windows}, err
+}
+
+func ReplyMessageToProto(r message.ReplyMessage) *wsjtxpb.ReplyMessage {
+	return &wsjtxpb.ReplyMessage{
+		Id:               r.ID,
+		MsSinceMn:        r.MsSinceMN,
+		Snr:              r.SNR,
+		DeltaTime:        r.DeltaTime,
+		DeltaFrequencyHz: r.DeltaFrequencyHZ,
+		Mode:             r.Mode,
+		Message:          r.Message,
+		LowConfidence:    r.LowConfidence,
+		Modifiers:        uint32(r.Modifiers),
+	}
+}
+
+func ReplyMessageFromProto(pb *wsjtxpb.ReplyMessage) (message.ReplyMessage, error) {
+	modifiers, err := toUint8("modifiers", pb.GetModifiers())
+
+	return message.ReplyMessage{
+		ID:               pb.GetId(),
+		MsSinceMN:        pb.GetMsSinceMn(),
+		SNR:              pb.GetSnr(),
+		DeltaTime:        pb.GetDeltaTime(),
+		DeltaFrequencyHZ: pb.GetDeltaFrequencyHz(),
+		Mode:             pb.GetMode(),
+		Message:          pb.GetMessage(),
+		LowConfidence:    pb.GetLowConfidence(),
+		Modifiers:        modifiers,
+	}, err
+}
+
+func QSOLoggedMessageToProto(q message.QSOLoggedMessage) *wsjtxpb.QSOLoggedMessage {
+	return &wsjtxpb.QSOLoggedMessage{
+		Id:                  q.ID,
+		DateAndTimeOff:      toTimestamp(q.DateAndTimeOff),
+		DxCall:              q.DXCall,
+		DxGrid:              q.DXGrid,
+		TxFrequencyHz:       q.TXFrequencyHZ,
+		Mode:                q.Mode,
+		ReportSent:          q.ReportSent,
+		ReportReceived:      q.ReportReceived,
+		TxPower:             q.TXPower,
+		Comments:            q.Comments,
+		Name:                q.Name,
+		DateAndTimeOn:       toTimestamp(q.DateAndTimeOn),
+		OperatorCall:        q.OperatorCall,
+		MyCall:              q.MyCall,
+		MyGrid:              q.MyGrid,
+		ExchangeSent:        q.ExchangeSent,
+		ExchangeReceived:    q.ExchangeReceived,
+		AdifPropagationMode: q.ADIFPropagationMode,
+	}
+}
+
+func QSOLoggedMessageFromProto(pb *wsjtxpb.QSOLoggedMessage) message.QSOLoggedMessage {
+	return message.QSOLoggedMessage{
+		ID:                  pb.GetId(),
+		DateAndTimeOff:      fromTimestamp(pb.GetDateAndTimeOff()),
+		DXCall:              pb.GetDxCall(),
+		DXGrid:              pb.GetDxGrid(),
+		TXFrequencyHZ:       pb.GetTxFrequencyHz(),
+		Mode:                pb.GetMode(),
+		ReportSent:          pb.GetReportSent(),
+		ReportReceived:      pb.GetReportReceived(),
+		TXPower:             pb.GetTxPower(),
+		Comments:            pb.GetComments(),
+		Name:                pb.GetName(),
+		DateAndTimeOn:       fromTimestamp(pb.GetDateAndTimeOn()),
+		OperatorCall:        pb.GetOperatorCall(),
+		MyCall:              pb.GetMyCall(),
+		MyGrid:              pb.GetMyGrid(),
+		ExchangeSent:        pb.GetExchangeSent(),
+		ExchangeReceived:    pb.GetExchangeReceived(),
+		ADIFPropagationMode: pb.GetAdifPropagationMode(),
+	}
+}
+
+func CloseMessageToProto(c message.CloseMessage) *wsjtxpb.CloseMessage {
+	return &wsjtxpb.CloseMessage{Id: c.ID}
+}
+
+func CloseMessageFromProto(pb *wsjtxpb.CloseMessage) message.CloseMessage {
+	return message.CloseMessage{ID: pb.GetId()}
+}
+
+func ReplayMessageToProto(r message.ReplayMessage) *wsjtxpb.ReplayMessage {
+	return &wsjtxpb.ReplayMessage{Id: r.ID}
+}
+
+func ReplayMessageFromProto(pb *wsjtxpb.ReplayMessage) message.ReplayMessage {
+	return message.ReplayMessage{ID: pb.GetId()}
+}
+
+func HaltTXMessageToProto(h message.HaltTXMessage) *wsjtxpb.HaltTXMessage {
+	return &wsjtxpb.HaltTXMessage{Id: h.ID, Auto: h.Auto}
+}
+
+func HaltTXMessageFromProto(pb *wsjtxpb.HaltTXMessage) message.HaltTXMessage {
+	return message.HaltTXMessage{ID: pb.GetId(), Auto: pb.GetAuto()}
+}
+
+func FreeTextMessageToProto(f message.FreeTextMessage) *wsjtxpb.FreeTextMessage {
+	return &wsjtxpb.FreeTextMessage{Id: f.ID, Text: f.Text, Send: f.Send}
+}
+
+func FreeTextMessageFromProto(pb *wsjtxpb.FreeTextMessage) message.FreeTextMessage {
+	return message.FreeTextMessage{ID: pb.GetId(), Text: pb.GetText(), Send: pb.GetSend()}
+}
+
+func WSPRDecodeMessageToProto(w message.WSPRDecodeMessage) *wsjtxpb.WSPRDecodeMessage {
+	return &wsjtxpb.WSPRDecodeMessage{
+		Id:          w.ID,
+		New:         w.New,
+		Time:        w.Time,
+		FullTime:    toTimestamp(w.FullTime),
+		Snr:         w.SNR,
+		DeltaTime:   w.DeltaTime,
+		FrequencyHz: w.FrequencyHZ,
+		DriftHz:     w.DriftHz,
+		Callsign:    w.Callsign,
+		Grid:        w.Grid,
+		PowerDbm:    w.PowerdBm,
+		PowerWatts:  w.PowerWatts,
+		OffAir:      w.OffAir,
+	}
+}
+
+func WSPRDecodeMessageFromProto(pb *wsjtxpb.WSPRDecodeMessage) message.WSPRDecodeMessage {
+	return message.WSPRDecodeMessage{
+		ID:          pb.GetId(),
+		New:         pb.GetNew(),
+		Time:        pb.GetTime(),
+		FullTime:    fromTimestamp(pb.GetFullTime()),
+		SNR:         pb.GetSnr(),
+		DeltaTime:   pb.GetDeltaTime(),
+		FrequencyHZ: pb.GetFrequencyHz(),
+		DriftHz:     pb.GetDriftHz(),
+		Callsign:    pb.GetCallsign(),
+		Grid:        pb.GetGrid(),
+		PowerdBm:    pb.GetPowerDbm(),
+		PowerWatts:  pb.GetPowerWatts(),
+		OffAir:      pb.GetOffAir(),
+	}
+}
+
+func LocationMessageToProto(l message.LocationMessage) *wsjtxpb.LocationMessage {
+	return &wsjtxpb.LocationMessage{Id: l.ID, Location: l.Location}
+}
+
+func LocationMessageFromProto(pb *wsjtxpb.LocationMessage) message.LocationMessage {
+	return message.LocationMessage{ID: pb.GetId(), Location: pb.GetLocation()}
+}
+
+func HighlightCallsignMessageToProto(h message.HighlightCallsignMessage) *wsjtxpb.HighlightCallsignMessage {
+	return &wsjtxpb.HighlightCallsignMessage{
+		Id:              h.ID,
+		Callsign:        h.Callsign,
+		BackgroundColor: QColorToProto(h.BackgroundColor),
+		ForegroundColor: QColorToProto(h.ForegroundColor),
+		HighlightLast:   h.HighlightLast,
+	}
+}
+
+func HighlightCallsignMessageFromProto(pb *wsjtxpb.HighlightCallsignMessage) (message.HighlightCallsignMessage, error) {
+	bg, err := QColorFromProto(pb.GetBackgroundColor())
+	if err != nil {
+		return message.HighlightCallsignMessage{}, err
+	}
+
+	fg, err := QColorFromProto(pb.GetForegroundColor())
+	if err != nil {
+		return message.HighlightCallsignMessage{}, err
+	}
+
+	return message.HighlightCallsignMessage{
+		ID:              pb.GetId(),
+		Callsign:        pb.GetCallsign(),
+		BackgroundColor: bg,
+		ForegroundColor: fg,
+		HighlightLast:   pb.GetHighlightLast(),
+	}, nil
+}
+
+func QColorToProto(c message.QColor) *wsjtxpb.QColor {
+	return &wsjtxpb.QColor{
+		Alpha: uint32(c.Alpha),
+		Red:   uint32(c.Red),
+		Green: uint32(c.Green),
+		Blue:  uint32(c.Blue),
+	}
+}
+
+// QColorFromProto converts a color; an unset color is the zero, invalid, QColor.
+func QColorFromProto(pb *wsjtxpb.QColor) (message.QColor, error) {
+	var (
+		c   message.QColor
+		err error
+	)
+
+	if c.Alpha, err = toUint16("alpha", pb.GetAlpha()); err != nil {
+		return message.QColor{}, err
+	}
+
+	if c.Red, err = toUint16("red", pb.GetRed()); err != nil {
+		return message.QColor{}, err
+	}
+
+	if c.Green, err = toUint16("green", pb.GetGreen()); err != nil {
+		return message.QColor{}, err
+	}
+
+	if c.Blue, err = toUint16("blue", pb.GetBlue()); err != nil {
+		return message.QColor{}, err
+	}
+
+	return c, nil
+}
+
+func SwitchConfigurationMessageToProto(s message.SwitchConfigurationMessage) *wsjtxpb.SwitchConfigurationMessage {
+	return &wsjtxpb.SwitchConfigurationMessage{Id: s.ID, ConfigurationName: s.ConfigurationName}
+}
+
+func SwitchConfigurationMessageFromProto(pb *wsjtxpb.SwitchConfigurationMessage) message.SwitchConfigurationMessage {
+	return message.SwitchConfigurationMessage{ID: pb.GetId(), ConfigurationName: pb.GetConfigurationName()}
+}
+
+func ConfigurationMessageToProto(c message.ConfigurationMessage) *wsjtxpb.ConfigurationMessage {
+	return &wsjtxpb.ConfigurationMessage{
+		Id:                 c.ID,
+		Mode:               c.Mode,
+		FrequencyTolerance: c.FrequencyTolerance,
+		Submode:            c.Submode,
+		FastMode:           c.FastMode,
+		TrPeriod:           c.TRPeriod,
+		RxDf:               c.RXDF,
+		DxCall:             c.DXCall,
+		DxGrid:             c.DXGrid,
+		GenerateMessage:    c.GenerateMessage,
+	}
+}
+
+func ConfigurationMessageFromProto(pb *wsjtxpb.ConfigurationMessage) message.ConfigurationMessage {
+	return message.ConfigurationMessage{
+		ID:                 pb.GetId(),
+		Mode:               pb.GetMode(),
+		FrequencyTolerance: pb.GetFrequencyTolerance(),
+		Submode:            pb.GetSubmode(),
+		FastMode:           pb.GetFastMode(),
+		TRPeriod:           pb.GetTrPeriod(),
+		RXDF:               pb.GetRxDf(),
+		DXCall:             pb.GetDxCall(),
+		DXGrid:             pb.GetDxGrid(),
+		GenerateMessage:    pb.GetGenerateMessage(),
+	}
+}
@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/grpcapi/wsjtxpb"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func TestMessageRoundTrip(t *testing.T) {
+	at := time.Date(2021, 3, 4, 5, 6, 7, 890, time.UTC)
+	addr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 55000}
+
+	tests := []struct {
+		name string
+		resp message.Response
+	}{
+		{name: "heartbeat", resp: message.Response{ResponseType: message.HeartbeatType, Message: message.HeartbeatResponse{ID: "WSJT-X", MaxSchemaNumber: 3, Version: "2.5.2", Revision: "abc"}}},
+		{name: "status", resp: message.Response{ResponseType: message.StatusType, Message: message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8", DXCall: "K1ABC", TXEnabled: true, RXDF: 1500, TXDF: 1200, DEGrid: "JN45", TRPeriod: 15, TXMessage: "K1ABC IU2ABC JN45"}}},
+		{name: "decode", resp: message.Response{ResponseType: message.DecodeType, Message: message.DecodeResponse{ID: "WSJT-X", New: true, Time: 3600000, FullTime: at, SNR: -21, DeltaTime: 0.2, DeltaFrequencyHz: 1234, Mode: "~", Message: "CQ K1ABC FN42", LowConfidence: true}}},
+		{name: "decode without time", resp: message.Response{ResponseType: message.DecodeType, Message: message.DecodeResponse{ID: "WSJT-X", Message: "CQ K1ABC FN42"}}},
+		{name: "clear", resp: message.Response{ResponseType: message.ClearType, Message: message.ClearResponse{ID: "WSJT-X", Windows: 2}}},
+		{name: "qso logged", resp: message.Response{ResponseType: message.QSOLoggedType, Message: message.QSOLoggedResponse{ID: "WSJT-X", DateAndTimeOff: at, DateAndTimeOn: at.Add(-time.Minute), DXCall: "K1ABC", TXFrequencyHz: 14074000, ReportSent: "-10", ADIFPropagationMode: "ES"}}},
+		{name: "close", resp: message.Response{ResponseType: message.CloseType, Message: message.CloseResponse{ID: "WSJT-X"}}},
+		{name: "wspr", resp: message.Response{ResponseType: message.WSPRDecodeType, Message: message.WSPRDecodeResponse{ID: "WSJT-X", FullTime: at, SNR: -28, FrequencyHz: 14097050, DriftHz: -1, Callsign: "K1ABC", Grid: "FN42", PowerdBm: 37, PowerWatt: 5.01, OffAir: true}}},
+		{name: "logged adif", resp: message.Response{ResponseType: message.LoggedADIFType, Message: message.LoggedADIFResponse{ID: "WSJT-X", ADIF: "<call:5>K1ABC <eor>"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := udpserver.Message{Response: tt.resp, Addr: addr, Received: at}
+
+			pb, err := MessageToProto(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := MessageFromProto(pb)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got.Response, m.Response) || !got.Received.Equal(m.Received) || got.Addr.String() != m.Addr.String() {
+				t.Errorf("round trip = %+v, want %+v", got, m)
+			}
+		})
+	}
+}
+
+func TestCommandRoundTrip(t *testing.T) {
+	at := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	reply := message.ReplyMessage{ID: "WSJT-X", MsSinceMN: 1000, SNR: -5, DeltaTime: 0.1, DeltaFrequencyHZ: 800, Mode: "~", Message: "CQ K1ABC FN42", Modifiers: message.ReplyShiftModifier}
+	if got, err := ReplyMessageFromProto(ReplyMessageToProto(reply)); err != nil || got != reply {
+		t.Errorf("reply = %+v, %v", got, err)
+	}
+
+	highlight := message.HighlightCallsignMessage{ID: "WSJT-X", Callsign: "K1ABC", BackgroundColor: message.QColor{Alpha: 0xffff, Red: 0xffff}, HighlightLast: true}
+	if got, err := HighlightCallsignMessageFromProto(HighlightCallsignMessageToProto(highlight)); err != nil || got != highlight {
+		t.Errorf("highlight = %+v, %v", got, err)
+	}
+
+	qso := message.QSOLoggedMessage{ID: "WSJT-X", DateAndTimeOff: at, DateAndTimeOn: at, DXCall: "K1ABC", TXFrequencyHZ: 7074000}
+	if got := QSOLoggedMessageFromProto(QSOLoggedMessageToProto(qso)); got != qso {
+		t.Errorf("qso = %+v", got)
+	}
+
+	configure := message.ConfigurationMessage{ID: "WSJT-X", Mode: "FT4", FrequencyTolerance: 50, TRPeriod: 7, RXDF: 1500, DXCall: "K1ABC", GenerateMessage: true}
+	if got := ConfigurationMessageFromProto(ConfigurationMessageToProto(configure)); got != configure {
+		t.Errorf("configure = %+v", got)
+	}
+
+	if _, err := ClearMessageFromProto(&wsjtxpb.ClearMessage{Windows: 256}); err == nil {
+		t.Error("clear windows 256 accepted")
+	}
+
+	if _, err := QColorFromProto(&wsjtxpb.QColor{Red: 0x10000}); err == nil {
+		t.Error("red 0x10000 accepted")
+	}
+}
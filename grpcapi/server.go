@@ -0,0 +1,328 @@
+// Package grpcapi serves the messages of the WSJT-X instances and accepts their
+// commands over gRPC. The service is described by wsjtxpb/wsjtx.proto.
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/command"
+	"github.com/logocomune/wsjtx/grpcapi/wsjtxpb"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultStreamBuffer is the number of messages queued for each stream.
+	DefaultStreamBuffer = 64
+
+	writeTimeout = 5 * time.Second
+)
+
+// Writer sends datagrams to an address. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+// Instances lists the known instances. It is implemented by *instance.Registry.
+type Instances interface {
+	Get(id string) (instance.Instance, bool)
+	List() []instance.Instance
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// Server implements wsjtxpb.WSJTXServer.
+type Server struct {
+	wsjtxpb.UnimplementedWSJTXServer
+
+	w         Writer
+	instances Instances
+	log       logger
+	buffer    int
+	authorize func(context.Context) bool
+	mu        sync.RWMutex
+	// streams are the open streams, with the number of messages they dropped.
+	streams map[chan udpserver.Message]*int
+	bands   map[string]string
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithStreamBuffer sets the number of messages queued for each stream.
+// Messages are dropped for the streams whose queue is full.
+func WithStreamBuffer(n int) Option {
+	return func(s *Server) {
+		if n > 0 {
+			s.buffer = n
+		}
+	}
+}
+
+// WithAuthorizer sets which calls may send commands, from the context of the
+// RPC with its metadata and peer. By default none can.
+func WithAuthorizer(authorize func(ctx context.Context) bool) Option {
+	return func(s *Server) {
+		s.authorize = authorize
+	}
+}
+
+func NewServer(w Writer, instances Instances, logger logger, opts ...Option) *Server {
+	s := &Server{
+		w:         w,
+		instances: instances,
+		log:       logger,
+		buffer:    DefaultStreamBuffer,
+		authorize: func(context.Context) bool { return false },
+		streams:   make(map[chan udpserver.Message]*int),
+		bands:     make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Register streams every message dispatched by router.
+func (s *Server) Register(router *udpserver.Router) {
+	router.HandleAll(s.Publish)
+}
+
+// RegisterService registers s on g.
+func (s *Server) RegisterService(g *grpc.Server) {
+	wsjtxpb.RegisterWSJTXServer(g, s)
+}
+
+// Publish queues m on the open streams.
+func (s *Server) Publish(m udpserver.Message) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := m.Message.(message.StatusResponse); ok {
+		s.bands[m.InstanceID()] = band.FromFrequency(st.Dial)
+	}
+
+	for ch, dropped := range s.streams {
+		select {
+		case ch <- m:
+		default:
+			// Logged once per stream, then counted until it ends.
+			if *dropped == 0 {
+				s.log.Println("grpcapi: stream full, dropping messages")
+			}
+
+			*dropped++
+		}
+	}
+}
+
+func (s *Server) band(id string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.bands[id]
+}
+
+func (s *Server) StreamMessages(req *wsjtxpb.StreamMessagesRequest, stream wsjtxpb.WSJTX_StreamMessagesServer) error {
+	f := udpserver.Filter{
+		Types:    req.GetTypes(),
+		Instance: req.GetInstance(),
+		Band:     req.GetBand(),
+		Callsign: req.GetCallsign(),
+	}
+
+	ch := make(chan udpserver.Message, s.buffer)
+
+	var dropped int
+
+	s.mu.Lock()
+	s.streams[ch] = &dropped
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.streams, ch)
+		s.mu.Unlock()
+
+		if dropped > 0 {
+			s.log.Println("grpcapi: stream ended,", dropped, "messages dropped")
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case m := <-ch:
+			if !f.Match(m, s.band(m.InstanceID())) {
+				continue
+			}
+
+			pb, err := MessageToProto(m)
+			if err != nil {
+				s.log.Println(err)
+
+				continue
+			}
+
+			if err := stream.Send(pb); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) ListInstances(context.Context, *wsjtxpb.ListInstancesRequest) (*wsjtxpb.ListInstancesResponse, error) {
+	resp := &wsjtxpb.ListInstancesResponse{}
+
+	for _, i := range s.instances.List() {
+		resp.Instances = append(resp.Instances, InstanceToProto(i))
+	}
+
+	return resp, nil
+}
+
+// InstanceToProto converts an instance of the registry.
+func InstanceToProto(i instance.Instance) *wsjtxpb.Instance {
+	pb := &wsjtxpb.Instance{
+		Id:              i.ID,
+		Version:         i.Version,
+		Revision:        i.Revision,
+		MaxSchemaNumber: i.MaxSchemaNumber,
+		Up:              i.Up,
+		FirstSeen:       toTimestamp(i.FirstSeen),
+		LastSeen:        toTimestamp(i.LastSeen),
+		LastHeartbeat:   toTimestamp(i.LastHeartbeat),
+	}
+
+	if i.Addr != nil {
+		pb.Addr = i.Addr.String()
+	}
+
+	if i.Status != nil {
+		pb.Status = StatusResponseToProto(*i.Status)
+	}
+
+	return pb
+}
+
+func (s *Server) Reply(ctx context.Context, req *wsjtxpb.ReplyMessage) (*wsjtxpb.CommandResponse, error) {
+	m, err := ReplyMessageFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.command(ctx, command.Reply, m.ID, m)
+}
+
+func (s *Server) HaltTX(ctx context.Context, req *wsjtxpb.HaltTXMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.HaltTX, req.GetId(), HaltTXMessageFromProto(req))
+}
+
+func (s *Server) FreeText(ctx context.Context, req *wsjtxpb.FreeTextMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.FreeText, req.GetId(), FreeTextMessageFromProto(req))
+}
+
+func (s *Server) Clear(ctx context.Context, req *wsjtxpb.ClearMessage) (*wsjtxpb.CommandResponse, error) {
+	m, err := ClearMessageFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.command(ctx, command.Clear, m.ID, m)
+}
+
+func (s *Server) Replay(ctx context.Context, req *wsjtxpb.ReplayMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.Replay, req.GetId(), nil)
+}
+
+// Close asks the instance to exit; the command package has no equivalent.
+func (s *Server) Close(ctx context.Context, req *wsjtxpb.CloseMessage) (*wsjtxpb.CommandResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id: required")
+	}
+
+	return s.send(ctx, req.GetId(), message.EncodeClose(CloseMessageFromProto(req)))
+}
+
+func (s *Server) Location(ctx context.Context, req *wsjtxpb.LocationMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.Location, req.GetId(), LocationMessageFromProto(req))
+}
+
+func (s *Server) HighlightCallsign(ctx context.Context, req *wsjtxpb.HighlightCallsignMessage) (*wsjtxpb.CommandResponse, error) {
+	m, err := HighlightCallsignMessageFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return s.command(ctx, command.HighlightCallsign, m.ID, m)
+}
+
+func (s *Server) SwitchConfiguration(ctx context.Context, req *wsjtxpb.SwitchConfigurationMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.SwitchConfiguration, req.GetId(), SwitchConfigurationMessageFromProto(req))
+}
+
+func (s *Server) Configure(ctx context.Context, req *wsjtxpb.ConfigurationMessage) (*wsjtxpb.CommandResponse, error) {
+	return s.command(ctx, command.Configure, req.GetId(), ConfigurationMessageFromProto(req))
+}
+
+// command encodes m through the command package, to validate it like the
+// other APIs do, and sends it to the instance id.
+func (s *Server) command(ctx context.Context, name, id string, m interface{}) (*wsjtxpb.CommandResponse, error) {
+	var params []byte
+
+	if m != nil {
+		var err error
+		if params, err = json.Marshal(m); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	data, err := command.Encode(name, id, params)
+
+	var verr *command.ValidationError
+	if errors.As(err, &verr) {
+		return nil, status.Error(codes.InvalidArgument, verr.Error())
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return s.send(ctx, id, data)
+}
+
+func (s *Server) send(ctx context.Context, id string, data []byte) (*wsjtxpb.CommandResponse, error) {
+	if !s.authorize(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "not authorized to send commands")
+	}
+
+	inst, ok := s.instances.Get(id)
+	if !ok || inst.Addr == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown instance %q", id)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	if err := s.w.WriteTo(ctx, data, inst.Addr); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &wsjtxpb.CommandResponse{}, nil
+}
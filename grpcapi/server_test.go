@@ -0,0 +1,197 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/logocomune/wsjtx/grpcapi/wsjtxpb"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+type fakeWriter struct {
+	mu   sync.Mutex
+	sent [][]byte
+}
+
+func (f *fakeWriter) WriteTo(_ context.Context, msg []byte, _ *net.UDPAddr) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sent = append(f.sent, msg)
+
+	return nil
+}
+
+func newTestClient(t *testing.T, s *Server) wsjtxpb.WSJTXClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	g := grpc.NewServer()
+	s.RegisterService(g)
+
+	go g.Serve(lis)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		g.Stop()
+	})
+
+	return wsjtxpb.NewWSJTXClient(conn)
+}
+
+func TestServer(t *testing.T) {
+	registry := instance.NewRegistry(nil, log.New(io.Discard, "", 0))
+	addr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 55000}
+	registry.Heartbeat(addr, message.HeartbeatResponse{ID: "WSJT-X", Version: "2.5.2"})
+
+	w := &fakeWriter{}
+	s := NewServer(w, registry, log.New(io.Discard, "", 0), WithAuthorizer(func(ctx context.Context) bool {
+		md, _ := metadata.FromIncomingContext(ctx)
+		return len(md.Get("authorization")) == 1 && md.Get("authorization")[0] == "Bearer secret"
+	}))
+	client := newTestClient(t, s)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+
+	list, err := client.ListInstances(ctx, &wsjtxpb.ListInstancesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(list.Instances) != 1 || list.Instances[0].Id != "WSJT-X" || list.Instances[0].Addr != "127.0.0.1:55000" {
+		t.Errorf("instances = %v", list.Instances)
+	}
+
+	if _, err := client.HaltTX(ctx, &wsjtxpb.HaltTXMessage{Id: "WSJT-X", Auto: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.sent) != 1 || string(w.sent[0]) != string(message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: true})) {
+		t.Errorf("sent %x", w.sent)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{name: "unknown instance", want: codes.NotFound, call: func() error {
+			_, err := client.Replay(ctx, &wsjtxpb.ReplayMessage{Id: "JTDX"})
+			return err
+		}},
+		{name: "missing callsign", want: codes.InvalidArgument, call: func() error {
+			_, err := client.HighlightCallsign(ctx, &wsjtxpb.HighlightCallsignMessage{Id: "WSJT-X"})
+			return err
+		}},
+		{name: "clear windows", want: codes.InvalidArgument, call: func() error {
+			_, err := client.Clear(ctx, &wsjtxpb.ClearMessage{Id: "WSJT-X", Windows: 3})
+			return err
+		}},
+		{name: "close without id", want: codes.InvalidArgument, call: func() error {
+			_, err := client.Close(ctx, &wsjtxpb.CloseMessage{})
+			return err
+		}},
+		{name: "close not authorized", want: codes.PermissionDenied, call: func() error {
+			_, err := client.Close(context.Background(), &wsjtxpb.CloseMessage{Id: "WSJT-X"})
+			return err
+		}},
+		{name: "command not authorized", want: codes.PermissionDenied, call: func() error {
+			_, err := client.HaltTX(context.Background(), &wsjtxpb.HaltTXMessage{Id: "WSJT-X"})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(tt.call()); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if len(w.sent) != 1 {
+		t.Errorf("sent %d commands, want only the authorized one", len(w.sent))
+	}
+}
+
+func TestStreamMessages(t *testing.T) {
+	s := NewServer(&fakeWriter{}, instance.NewRegistry(nil, log.New(io.Discard, "", 0)), log.New(io.Discard, "", 0))
+	client := newTestClient(t, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamMessages(ctx, &wsjtxpb.StreamMessagesRequest{Types: []string{message.DecodeType}, Band: "20m", Callsign: "K1*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Wait for the stream to be registered before publishing.
+	for {
+		s.mu.RLock()
+		n := len(s.streams)
+		s.mu.RUnlock()
+
+		if n == 1 {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	publish := func(resp interface{}, typ string) {
+		s.Publish(udpserver.Message{Response: message.Response{ResponseType: typ, Message: resp}, Received: time.Now()})
+	}
+
+	publish(message.StatusResponse{ID: "WSJT-X", Dial: 14074000}, message.StatusType)
+	publish(message.DecodeResponse{ID: "WSJT-X", Message: "CQ W1AW FN31"}, message.DecodeType)
+	publish(message.DecodeResponse{ID: "WSJT-X", Message: "CQ K1ABC FN42"}, message.DecodeType)
+
+	m, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Type != message.DecodeType || m.GetDecode().GetMessage() != "CQ K1ABC FN42" {
+		t.Errorf("received %v", m)
+	}
+}
+
+func TestPublishDropped(t *testing.T) {
+	var logs bytes.Buffer
+
+	s := NewServer(&fakeWriter{}, instance.NewRegistry(nil, log.New(io.Discard, "", 0)), log.New(&logs, "", 0), WithStreamBuffer(1))
+
+	var dropped int
+
+	ch := make(chan udpserver.Message, s.buffer)
+	s.streams[ch] = &dropped
+
+	for i := 0; i < 3; i++ {
+		s.Publish(udpserver.Message{Response: message.Response{ResponseType: message.HeartbeatType, Message: message.HeartbeatResponse{ID: "WSJT-X"}}})
+	}
+
+	if dropped != 2 || strings.Count(logs.String(), "\n") != 1 {
+		t.Errorf("dropped = %d, logs = %q, want 2 drops logged once", dropped, logs.String())
+	}
+}
@@ -0,0 +1,5 @@
+// Package wsjtxpb holds the protobuf messages and the gRPC service generated
+// from wsjtx.proto.
+package wsjtxpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative wsjtx.proto
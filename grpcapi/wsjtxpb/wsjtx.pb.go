@@ -0,0 +1,3934 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.21.12
+// source: wsjtx.proto
+
+package wsjtxpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StreamMessagesRequest selects the streamed messages. Empty fields match everything.
+type StreamMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Message types, like DECODE.
+	Types    []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	Instance string   `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	// Band name, like 20m.
+	Band string `protobuf:"bytes,3,opt,name=band,proto3" json:"band,omitempty"`
+	// Glob pattern matched against the callsigns of the message.
+	Callsign string `protobuf:"bytes,4,opt,name=callsign,proto3" json:"callsign,omitempty"`
+}
+
+func (x *StreamMessagesRequest) Reset() {
+	*x = StreamMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMessagesRequest) ProtoMessage() {}
+
+func (x *StreamMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StreamMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamMessagesRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StreamMessagesRequest) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *StreamMessagesRequest) GetBand() string {
+	if x != nil {
+		return x.Band
+	}
+	return ""
+}
+
+func (x *StreamMessagesRequest) GetCallsign() string {
+	if x != nil {
+		return x.Callsign
+	}
+	return ""
+}
+
+// Message is a message received from an instance.
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Instance string                 `protobuf:"bytes,2,opt,name=instance,proto3" json:"instance,omitempty"`
+	Addr     string                 `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Received *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=received,proto3" json:"received,omitempty"`
+	// Types that are assignable to Payload:
+	//	*Message_Heartbeat
+	//	*Message_Status
+	//	*Message_Decode
+	//	*Message_Clear
+	//	*Message_QsoLogged
+	//	*Message_Close
+	//	*Message_WsprDecode
+	//	*Message_LoggedAdif
+	Payload isMessage_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Message) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Message) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+func (x *Message) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Message) GetReceived() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Received
+	}
+	return nil
+}
+
+func (m *Message) GetPayload() isMessage_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *Message) GetHeartbeat() *HeartbeatResponse {
+	if x, ok := x.GetPayload().(*Message_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Message) GetStatus() *StatusResponse {
+	if x, ok := x.GetPayload().(*Message_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Message) GetDecode() *DecodeResponse {
+	if x, ok := x.GetPayload().(*Message_Decode); ok {
+		return x.Decode
+	}
+	return nil
+}
+
+func (x *Message) GetClear() *ClearResponse {
+	if x, ok := x.GetPayload().(*Message_Clear); ok {
+		return x.Clear
+	}
+	return nil
+}
+
+func (x *Message) GetQsoLogged() *QSOLoggedResponse {
+	if x, ok := x.GetPayload().(*Message_QsoLogged); ok {
+		return x.QsoLogged
+	}
+	return nil
+}
+
+func (x *Message) GetClose() *CloseResponse {
+	if x, ok := x.GetPayload().(*Message_Close); ok {
+		return x.Close
+	}
+	return nil
+}
+
+func (x *Message) GetWsprDecode() *WSPRDecodeResponse {
+	if x, ok := x.GetPayload().(*Message_WsprDecode); ok {
+		return x.WsprDecode
+	}
+	return nil
+}
+
+func (x *Message) GetLoggedAdif() *LoggedADIFResponse {
+	if x, ok := x.GetPayload().(*Message_LoggedAdif); ok {
+		return x.LoggedAdif
+	}
+	return nil
+}
+
+type isMessage_Payload interface {
+	isMessage_Payload()
+}
+
+type Message_Heartbeat struct {
+	Heartbeat *HeartbeatResponse `protobuf:"bytes,10,opt,name=heartbeat,proto3,oneof"`
+}
+
+type Message_Status struct {
+	Status *StatusResponse `protobuf:"bytes,11,opt,name=status,proto3,oneof"`
+}
+
+type Message_Decode struct {
+	Decode *DecodeResponse `protobuf:"bytes,12,opt,name=decode,proto3,oneof"`
+}
+
+type Message_Clear struct {
+	Clear *ClearResponse `protobuf:"bytes,13,opt,name=clear,proto3,oneof"`
+}
+
+type Message_QsoLogged struct {
+	QsoLogged *QSOLoggedResponse `protobuf:"bytes,14,opt,name=qso_logged,json=qsoLogged,proto3,oneof"`
+}
+
+type Message_Close struct {
+	Close *CloseResponse `protobuf:"bytes,15,opt,name=close,proto3,oneof"`
+}
+
+type Message_WsprDecode struct {
+	WsprDecode *WSPRDecodeResponse `protobuf:"bytes,16,opt,name=wspr_decode,json=wsprDecode,proto3,oneof"`
+}
+
+type Message_LoggedAdif struct {
+	LoggedAdif *LoggedADIFResponse `protobuf:"bytes,17,opt,name=logged_adif,json=loggedAdif,proto3,oneof"`
+}
+
+func (*Message_Heartbeat) isMessage_Payload() {}
+
+func (*Message_Status) isMessage_Payload() {}
+
+func (*Message_Decode) isMessage_Payload() {}
+
+func (*Message_Clear) isMessage_Payload() {}
+
+func (*Message_QsoLogged) isMessage_Payload() {}
+
+func (*Message_Close) isMessage_Payload() {}
+
+func (*Message_WsprDecode) isMessage_Payload() {}
+
+func (*Message_LoggedAdif) isMessage_Payload() {}
+
+type ListInstancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListInstancesRequest) Reset() {
+	*x = ListInstancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstancesRequest) ProtoMessage() {}
+
+func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{2}
+}
+
+type ListInstancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instances []*Instance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+}
+
+func (x *ListInstancesResponse) Reset() {
+	*x = ListInstancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInstancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstancesResponse) ProtoMessage() {}
+
+func (x *ListInstancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstancesResponse.ProtoReflect.Descriptor instead.
+func (*ListInstancesResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListInstancesResponse) GetInstances() []*Instance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+type Instance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version         string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Revision        string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	MaxSchemaNumber uint32 `protobuf:"varint,4,opt,name=max_schema_number,json=maxSchemaNumber,proto3" json:"max_schema_number,omitempty"`
+	Addr            string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	// Latest status, unset until the first one.
+	Status        *StatusResponse        `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Up            bool                   `protobuf:"varint,7,opt,name=up,proto3" json:"up,omitempty"`
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+}
+
+func (x *Instance) Reset() {
+	*x = Instance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Instance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instance) ProtoMessage() {}
+
+func (x *Instance) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instance.ProtoReflect.Descriptor instead.
+func (*Instance) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Instance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Instance) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Instance) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *Instance) GetMaxSchemaNumber() uint32 {
+	if x != nil {
+		return x.MaxSchemaNumber
+	}
+	return 0
+}
+
+func (x *Instance) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Instance) GetStatus() *StatusResponse {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *Instance) GetUp() bool {
+	if x != nil {
+		return x.Up
+	}
+	return false
+}
+
+func (x *Instance) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *Instance) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Instance) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+type CommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CommandResponse) Reset() {
+	*x = CommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandResponse) ProtoMessage() {}
+
+func (x *CommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandResponse.ProtoReflect.Descriptor instead.
+func (*CommandResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{5}
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaxSchemaNumber uint32 `protobuf:"varint,2,opt,name=max_schema_number,json=maxSchemaNumber,proto3" json:"max_schema_number,omitempty"`
+	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Revision        string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HeartbeatResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HeartbeatResponse) GetMaxSchemaNumber() uint32 {
+	if x != nil {
+		return x.MaxSchemaNumber
+	}
+	return 0
+}
+
+func (x *HeartbeatResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartbeatResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Dial                 uint64 `protobuf:"varint,2,opt,name=dial,proto3" json:"dial,omitempty"`
+	Mode                 string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	DxCall               string `protobuf:"bytes,4,opt,name=dx_call,json=dxCall,proto3" json:"dx_call,omitempty"`
+	Report               string `protobuf:"bytes,5,opt,name=report,proto3" json:"report,omitempty"`
+	TxMode               string `protobuf:"bytes,6,opt,name=tx_mode,json=txMode,proto3" json:"tx_mode,omitempty"`
+	TxEnabled            bool   `protobuf:"varint,7,opt,name=tx_enabled,json=txEnabled,proto3" json:"tx_enabled,omitempty"`
+	Transmitting         bool   `protobuf:"varint,8,opt,name=transmitting,proto3" json:"transmitting,omitempty"`
+	Decoding             bool   `protobuf:"varint,9,opt,name=decoding,proto3" json:"decoding,omitempty"`
+	RxDf                 uint32 `protobuf:"varint,10,opt,name=rx_df,json=rxDf,proto3" json:"rx_df,omitempty"`
+	TxDf                 uint32 `protobuf:"varint,11,opt,name=tx_df,json=txDf,proto3" json:"tx_df,omitempty"`
+	DeCall               string `protobuf:"bytes,12,opt,name=de_call,json=deCall,proto3" json:"de_call,omitempty"`
+	DeGrid               string `protobuf:"bytes,13,opt,name=de_grid,json=deGrid,proto3" json:"de_grid,omitempty"`
+	DxGrid               string `protobuf:"bytes,14,opt,name=dx_grid,json=dxGrid,proto3" json:"dx_grid,omitempty"`
+	TxWatchdog           bool   `protobuf:"varint,15,opt,name=tx_watchdog,json=txWatchdog,proto3" json:"tx_watchdog,omitempty"`
+	SubMode              string `protobuf:"bytes,16,opt,name=sub_mode,json=subMode,proto3" json:"sub_mode,omitempty"`
+	FastMode             bool   `protobuf:"varint,17,opt,name=fast_mode,json=fastMode,proto3" json:"fast_mode,omitempty"`
+	SpecialOperationMode string `protobuf:"bytes,18,opt,name=special_operation_mode,json=specialOperationMode,proto3" json:"special_operation_mode,omitempty"`
+	FrequencyTolerance   uint32 `protobuf:"varint,19,opt,name=frequency_tolerance,json=frequencyTolerance,proto3" json:"frequency_tolerance,omitempty"`
+	TrPeriod             uint32 `protobuf:"varint,20,opt,name=tr_period,json=trPeriod,proto3" json:"tr_period,omitempty"`
+	ConfigurationName    string `protobuf:"bytes,21,opt,name=configuration_name,json=configurationName,proto3" json:"configuration_name,omitempty"`
+	TxMessage            string `protobuf:"bytes,22,opt,name=tx_message,json=txMessage,proto3" json:"tx_message,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StatusResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetDial() uint64 {
+	if x != nil {
+		return x.Dial
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetDxCall() string {
+	if x != nil {
+		return x.DxCall
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetTxMode() string {
+	if x != nil {
+		return x.TxMode
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetTxEnabled() bool {
+	if x != nil {
+		return x.TxEnabled
+	}
+	return false
+}
+
+func (x *StatusResponse) GetTransmitting() bool {
+	if x != nil {
+		return x.Transmitting
+	}
+	return false
+}
+
+func (x *StatusResponse) GetDecoding() bool {
+	if x != nil {
+		return x.Decoding
+	}
+	return false
+}
+
+func (x *StatusResponse) GetRxDf() uint32 {
+	if x != nil {
+		return x.RxDf
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetTxDf() uint32 {
+	if x != nil {
+		return x.TxDf
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetDeCall() string {
+	if x != nil {
+		return x.DeCall
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetDeGrid() string {
+	if x != nil {
+		return x.DeGrid
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetDxGrid() string {
+	if x != nil {
+		return x.DxGrid
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetTxWatchdog() bool {
+	if x != nil {
+		return x.TxWatchdog
+	}
+	return false
+}
+
+func (x *StatusResponse) GetSubMode() string {
+	if x != nil {
+		return x.SubMode
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetFastMode() bool {
+	if x != nil {
+		return x.FastMode
+	}
+	return false
+}
+
+func (x *StatusResponse) GetSpecialOperationMode() string {
+	if x != nil {
+		return x.SpecialOperationMode
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetFrequencyTolerance() uint32 {
+	if x != nil {
+		return x.FrequencyTolerance
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetTrPeriod() uint32 {
+	if x != nil {
+		return x.TrPeriod
+	}
+	return 0
+}
+
+func (x *StatusResponse) GetConfigurationName() string {
+	if x != nil {
+		return x.ConfigurationName
+	}
+	return ""
+}
+
+func (x *StatusResponse) GetTxMessage() string {
+	if x != nil {
+		return x.TxMessage
+	}
+	return ""
+}
+
+type DecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	New              bool                   `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Time             uint32                 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	FullTime         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=full_time,json=fullTime,proto3" json:"full_time,omitempty"`
+	Snr              int32                  `protobuf:"zigzag32,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	DeltaTime        float64                `protobuf:"fixed64,6,opt,name=delta_time,json=deltaTime,proto3" json:"delta_time,omitempty"`
+	DeltaFrequencyHz uint32                 `protobuf:"varint,7,opt,name=delta_frequency_hz,json=deltaFrequencyHz,proto3" json:"delta_frequency_hz,omitempty"`
+	Mode             string                 `protobuf:"bytes,8,opt,name=mode,proto3" json:"mode,omitempty"`
+	Message          string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	LowConfidence    bool                   `protobuf:"varint,10,opt,name=low_confidence,json=lowConfidence,proto3" json:"low_confidence,omitempty"`
+	OffAir           bool                   `protobuf:"varint,11,opt,name=off_air,json=offAir,proto3" json:"off_air,omitempty"`
+}
+
+func (x *DecodeResponse) Reset() {
+	*x = DecodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeResponse) ProtoMessage() {}
+
+func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DecodeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecodeResponse) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *DecodeResponse) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *DecodeResponse) GetFullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FullTime
+	}
+	return nil
+}
+
+func (x *DecodeResponse) GetSnr() int32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *DecodeResponse) GetDeltaTime() float64 {
+	if x != nil {
+		return x.DeltaTime
+	}
+	return 0
+}
+
+func (x *DecodeResponse) GetDeltaFrequencyHz() uint32 {
+	if x != nil {
+		return x.DeltaFrequencyHz
+	}
+	return 0
+}
+
+func (x *DecodeResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DecodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DecodeResponse) GetLowConfidence() bool {
+	if x != nil {
+		return x.LowConfidence
+	}
+	return false
+}
+
+func (x *DecodeResponse) GetOffAir() bool {
+	if x != nil {
+		return x.OffAir
+	}
+	return false
+}
+
+type ClearResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Windows uint32 `protobuf:"varint,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ClearResponse) Reset() {
+	*x = ClearResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearResponse) ProtoMessage() {}
+
+func (x *ClearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearResponse.ProtoReflect.Descriptor instead.
+func (*ClearResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ClearResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClearResponse) GetWindows() uint32 {
+	if x != nil {
+		return x.Windows
+	}
+	return 0
+}
+
+type QSOLoggedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateAndTimeOff      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_and_time_off,json=dateAndTimeOff,proto3" json:"date_and_time_off,omitempty"`
+	DxCall              string                 `protobuf:"bytes,3,opt,name=dx_call,json=dxCall,proto3" json:"dx_call,omitempty"`
+	DxGrid              string                 `protobuf:"bytes,4,opt,name=dx_grid,json=dxGrid,proto3" json:"dx_grid,omitempty"`
+	TxFrequencyHz       uint64                 `protobuf:"varint,5,opt,name=tx_frequency_hz,json=txFrequencyHz,proto3" json:"tx_frequency_hz,omitempty"`
+	Mode                string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	ReportSent          string                 `protobuf:"bytes,7,opt,name=report_sent,json=reportSent,proto3" json:"report_sent,omitempty"`
+	ReportReceived      string                 `protobuf:"bytes,8,opt,name=report_received,json=reportReceived,proto3" json:"report_received,omitempty"`
+	TxPower             string                 `protobuf:"bytes,9,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	Comments            string                 `protobuf:"bytes,10,opt,name=comments,proto3" json:"comments,omitempty"`
+	Name                string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	DateAndTimeOn       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=date_and_time_on,json=dateAndTimeOn,proto3" json:"date_and_time_on,omitempty"`
+	OperatorCall        string                 `protobuf:"bytes,13,opt,name=operator_call,json=operatorCall,proto3" json:"operator_call,omitempty"`
+	MyCall              string                 `protobuf:"bytes,14,opt,name=my_call,json=myCall,proto3" json:"my_call,omitempty"`
+	MyGrid              string                 `protobuf:"bytes,15,opt,name=my_grid,json=myGrid,proto3" json:"my_grid,omitempty"`
+	ExchangeSent        string                 `protobuf:"bytes,16,opt,name=exchange_sent,json=exchangeSent,proto3" json:"exchange_sent,omitempty"`
+	ExchangeReceived    string                 `protobuf:"bytes,17,opt,name=exchange_received,json=exchangeReceived,proto3" json:"exchange_received,omitempty"`
+	AdifPropagationMode string                 `protobuf:"bytes,18,opt,name=adif_propagation_mode,json=adifPropagationMode,proto3" json:"adif_propagation_mode,omitempty"`
+}
+
+func (x *QSOLoggedResponse) Reset() {
+	*x = QSOLoggedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QSOLoggedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QSOLoggedResponse) ProtoMessage() {}
+
+func (x *QSOLoggedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QSOLoggedResponse.ProtoReflect.Descriptor instead.
+func (*QSOLoggedResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QSOLoggedResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetDateAndTimeOff() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAndTimeOff
+	}
+	return nil
+}
+
+func (x *QSOLoggedResponse) GetDxCall() string {
+	if x != nil {
+		return x.DxCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetDxGrid() string {
+	if x != nil {
+		return x.DxGrid
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetTxFrequencyHz() uint64 {
+	if x != nil {
+		return x.TxFrequencyHz
+	}
+	return 0
+}
+
+func (x *QSOLoggedResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetReportSent() string {
+	if x != nil {
+		return x.ReportSent
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetReportReceived() string {
+	if x != nil {
+		return x.ReportReceived
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetTxPower() string {
+	if x != nil {
+		return x.TxPower
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetDateAndTimeOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAndTimeOn
+	}
+	return nil
+}
+
+func (x *QSOLoggedResponse) GetOperatorCall() string {
+	if x != nil {
+		return x.OperatorCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetMyCall() string {
+	if x != nil {
+		return x.MyCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetMyGrid() string {
+	if x != nil {
+		return x.MyGrid
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetExchangeSent() string {
+	if x != nil {
+		return x.ExchangeSent
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetExchangeReceived() string {
+	if x != nil {
+		return x.ExchangeReceived
+	}
+	return ""
+}
+
+func (x *QSOLoggedResponse) GetAdifPropagationMode() string {
+	if x != nil {
+		return x.AdifPropagationMode
+	}
+	return ""
+}
+
+type CloseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CloseResponse) Reset() {
+	*x = CloseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseResponse) ProtoMessage() {}
+
+func (x *CloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseResponse.ProtoReflect.Descriptor instead.
+func (*CloseResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CloseResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WSPRDecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	New         bool                   `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Time        uint32                 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	FullTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=full_time,json=fullTime,proto3" json:"full_time,omitempty"`
+	Snr         int32                  `protobuf:"zigzag32,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	DeltaTime   float64                `protobuf:"fixed64,6,opt,name=delta_time,json=deltaTime,proto3" json:"delta_time,omitempty"`
+	FrequencyHz uint64                 `protobuf:"varint,7,opt,name=frequency_hz,json=frequencyHz,proto3" json:"frequency_hz,omitempty"`
+	DriftHz     int32                  `protobuf:"zigzag32,8,opt,name=drift_hz,json=driftHz,proto3" json:"drift_hz,omitempty"`
+	Callsign    string                 `protobuf:"bytes,9,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	Grid        string                 `protobuf:"bytes,10,opt,name=grid,proto3" json:"grid,omitempty"`
+	PowerDbm    int32                  `protobuf:"zigzag32,11,opt,name=power_dbm,json=powerDbm,proto3" json:"power_dbm,omitempty"`
+	PowerWatt   float64                `protobuf:"fixed64,12,opt,name=power_watt,json=powerWatt,proto3" json:"power_watt,omitempty"`
+	OffAir      bool                   `protobuf:"varint,13,opt,name=off_air,json=offAir,proto3" json:"off_air,omitempty"`
+}
+
+func (x *WSPRDecodeResponse) Reset() {
+	*x = WSPRDecodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WSPRDecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WSPRDecodeResponse) ProtoMessage() {}
+
+func (x *WSPRDecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WSPRDecodeResponse.ProtoReflect.Descriptor instead.
+func (*WSPRDecodeResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WSPRDecodeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WSPRDecodeResponse) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *WSPRDecodeResponse) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetFullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FullTime
+	}
+	return nil
+}
+
+func (x *WSPRDecodeResponse) GetSnr() int32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetDeltaTime() float64 {
+	if x != nil {
+		return x.DeltaTime
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetFrequencyHz() uint64 {
+	if x != nil {
+		return x.FrequencyHz
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetDriftHz() int32 {
+	if x != nil {
+		return x.DriftHz
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetCallsign() string {
+	if x != nil {
+		return x.Callsign
+	}
+	return ""
+}
+
+func (x *WSPRDecodeResponse) GetGrid() string {
+	if x != nil {
+		return x.Grid
+	}
+	return ""
+}
+
+func (x *WSPRDecodeResponse) GetPowerDbm() int32 {
+	if x != nil {
+		return x.PowerDbm
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetPowerWatt() float64 {
+	if x != nil {
+		return x.PowerWatt
+	}
+	return 0
+}
+
+func (x *WSPRDecodeResponse) GetOffAir() bool {
+	if x != nil {
+		return x.OffAir
+	}
+	return false
+}
+
+type LoggedADIFResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Adif string `protobuf:"bytes,2,opt,name=adif,proto3" json:"adif,omitempty"`
+}
+
+func (x *LoggedADIFResponse) Reset() {
+	*x = LoggedADIFResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggedADIFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggedADIFResponse) ProtoMessage() {}
+
+func (x *LoggedADIFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggedADIFResponse.ProtoReflect.Descriptor instead.
+func (*LoggedADIFResponse) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoggedADIFResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoggedADIFResponse) GetAdif() string {
+	if x != nil {
+		return x.Adif
+	}
+	return ""
+}
+
+type HeartbeatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaxSchemaNumber uint32 `protobuf:"varint,2,opt,name=max_schema_number,json=maxSchemaNumber,proto3" json:"max_schema_number,omitempty"`
+	Version         string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Revision        string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *HeartbeatMessage) Reset() {
+	*x = HeartbeatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatMessage) ProtoMessage() {}
+
+func (x *HeartbeatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatMessage.ProtoReflect.Descriptor instead.
+func (*HeartbeatMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HeartbeatMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HeartbeatMessage) GetMaxSchemaNumber() uint32 {
+	if x != nil {
+		return x.MaxSchemaNumber
+	}
+	return 0
+}
+
+func (x *HeartbeatMessage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartbeatMessage) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type StatusMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Dial                 uint64 `protobuf:"varint,2,opt,name=dial,proto3" json:"dial,omitempty"`
+	Mode                 string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	DxCall               string `protobuf:"bytes,4,opt,name=dx_call,json=dxCall,proto3" json:"dx_call,omitempty"`
+	Report               string `protobuf:"bytes,5,opt,name=report,proto3" json:"report,omitempty"`
+	TxMode               string `protobuf:"bytes,6,opt,name=tx_mode,json=txMode,proto3" json:"tx_mode,omitempty"`
+	TxEnabled            bool   `protobuf:"varint,7,opt,name=tx_enabled,json=txEnabled,proto3" json:"tx_enabled,omitempty"`
+	Transmitting         bool   `protobuf:"varint,8,opt,name=transmitting,proto3" json:"transmitting,omitempty"`
+	Decoding             bool   `protobuf:"varint,9,opt,name=decoding,proto3" json:"decoding,omitempty"`
+	RxDf                 uint32 `protobuf:"varint,10,opt,name=rx_df,json=rxDf,proto3" json:"rx_df,omitempty"`
+	TxDf                 uint32 `protobuf:"varint,11,opt,name=tx_df,json=txDf,proto3" json:"tx_df,omitempty"`
+	DeCall               string `protobuf:"bytes,12,opt,name=de_call,json=deCall,proto3" json:"de_call,omitempty"`
+	DeGrid               string `protobuf:"bytes,13,opt,name=de_grid,json=deGrid,proto3" json:"de_grid,omitempty"`
+	DxGrid               string `protobuf:"bytes,14,opt,name=dx_grid,json=dxGrid,proto3" json:"dx_grid,omitempty"`
+	TxWatchdog           bool   `protobuf:"varint,15,opt,name=tx_watchdog,json=txWatchdog,proto3" json:"tx_watchdog,omitempty"`
+	SubMode              string `protobuf:"bytes,16,opt,name=sub_mode,json=subMode,proto3" json:"sub_mode,omitempty"`
+	FastMode             bool   `protobuf:"varint,17,opt,name=fast_mode,json=fastMode,proto3" json:"fast_mode,omitempty"`
+	SpecialOperationMode string `protobuf:"bytes,18,opt,name=special_operation_mode,json=specialOperationMode,proto3" json:"special_operation_mode,omitempty"`
+	FrequencyTolerance   uint32 `protobuf:"varint,19,opt,name=frequency_tolerance,json=frequencyTolerance,proto3" json:"frequency_tolerance,omitempty"`
+	TrPeriod             uint32 `protobuf:"varint,20,opt,name=tr_period,json=trPeriod,proto3" json:"tr_period,omitempty"`
+	ConfigurationName    string `protobuf:"bytes,21,opt,name=configuration_name,json=configurationName,proto3" json:"configuration_name,omitempty"`
+	TxMessage            string `protobuf:"bytes,22,opt,name=tx_message,json=txMessage,proto3" json:"tx_message,omitempty"`
+}
+
+func (x *StatusMessage) Reset() {
+	*x = StatusMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusMessage) ProtoMessage() {}
+
+func (x *StatusMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusMessage.ProtoReflect.Descriptor instead.
+func (*StatusMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *StatusMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetDial() uint64 {
+	if x != nil {
+		return x.Dial
+	}
+	return 0
+}
+
+func (x *StatusMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetDxCall() string {
+	if x != nil {
+		return x.DxCall
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetReport() string {
+	if x != nil {
+		return x.Report
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetTxMode() string {
+	if x != nil {
+		return x.TxMode
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetTxEnabled() bool {
+	if x != nil {
+		return x.TxEnabled
+	}
+	return false
+}
+
+func (x *StatusMessage) GetTransmitting() bool {
+	if x != nil {
+		return x.Transmitting
+	}
+	return false
+}
+
+func (x *StatusMessage) GetDecoding() bool {
+	if x != nil {
+		return x.Decoding
+	}
+	return false
+}
+
+func (x *StatusMessage) GetRxDf() uint32 {
+	if x != nil {
+		return x.RxDf
+	}
+	return 0
+}
+
+func (x *StatusMessage) GetTxDf() uint32 {
+	if x != nil {
+		return x.TxDf
+	}
+	return 0
+}
+
+func (x *StatusMessage) GetDeCall() string {
+	if x != nil {
+		return x.DeCall
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetDeGrid() string {
+	if x != nil {
+		return x.DeGrid
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetDxGrid() string {
+	if x != nil {
+		return x.DxGrid
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetTxWatchdog() bool {
+	if x != nil {
+		return x.TxWatchdog
+	}
+	return false
+}
+
+func (x *StatusMessage) GetSubMode() string {
+	if x != nil {
+		return x.SubMode
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetFastMode() bool {
+	if x != nil {
+		return x.FastMode
+	}
+	return false
+}
+
+func (x *StatusMessage) GetSpecialOperationMode() string {
+	if x != nil {
+		return x.SpecialOperationMode
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetFrequencyTolerance() uint32 {
+	if x != nil {
+		return x.FrequencyTolerance
+	}
+	return 0
+}
+
+func (x *StatusMessage) GetTrPeriod() uint32 {
+	if x != nil {
+		return x.TrPeriod
+	}
+	return 0
+}
+
+func (x *StatusMessage) GetConfigurationName() string {
+	if x != nil {
+		return x.ConfigurationName
+	}
+	return ""
+}
+
+func (x *StatusMessage) GetTxMessage() string {
+	if x != nil {
+		return x.TxMessage
+	}
+	return ""
+}
+
+type DecodeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	New              bool                   `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Time             uint32                 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	FullTime         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=full_time,json=fullTime,proto3" json:"full_time,omitempty"`
+	Snr              int32                  `protobuf:"zigzag32,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	DeltaTime        float64                `protobuf:"fixed64,6,opt,name=delta_time,json=deltaTime,proto3" json:"delta_time,omitempty"`
+	DeltaFrequencyHz uint32                 `protobuf:"varint,7,opt,name=delta_frequency_hz,json=deltaFrequencyHz,proto3" json:"delta_frequency_hz,omitempty"`
+	Mode             string                 `protobuf:"bytes,8,opt,name=mode,proto3" json:"mode,omitempty"`
+	Message          string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	LowConfidence    bool                   `protobuf:"varint,10,opt,name=low_confidence,json=lowConfidence,proto3" json:"low_confidence,omitempty"`
+	OffAir           bool                   `protobuf:"varint,11,opt,name=off_air,json=offAir,proto3" json:"off_air,omitempty"`
+}
+
+func (x *DecodeMessage) Reset() {
+	*x = DecodeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeMessage) ProtoMessage() {}
+
+func (x *DecodeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeMessage.ProtoReflect.Descriptor instead.
+func (*DecodeMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DecodeMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DecodeMessage) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *DecodeMessage) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *DecodeMessage) GetFullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FullTime
+	}
+	return nil
+}
+
+func (x *DecodeMessage) GetSnr() int32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *DecodeMessage) GetDeltaTime() float64 {
+	if x != nil {
+		return x.DeltaTime
+	}
+	return 0
+}
+
+func (x *DecodeMessage) GetDeltaFrequencyHz() uint32 {
+	if x != nil {
+		return x.DeltaFrequencyHz
+	}
+	return 0
+}
+
+func (x *DecodeMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DecodeMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DecodeMessage) GetLowConfidence() bool {
+	if x != nil {
+		return x.LowConfidence
+	}
+	return false
+}
+
+func (x *DecodeMessage) GetOffAir() bool {
+	if x != nil {
+		return x.OffAir
+	}
+	return false
+}
+
+type ClearMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Windows uint32 `protobuf:"varint,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ClearMessage) Reset() {
+	*x = ClearMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearMessage) ProtoMessage() {}
+
+func (x *ClearMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearMessage.ProtoReflect.Descriptor instead.
+func (*ClearMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClearMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClearMessage) GetWindows() uint32 {
+	if x != nil {
+		return x.Windows
+	}
+	return 0
+}
+
+type ReplyMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MsSinceMn        uint32  `protobuf:"varint,2,opt,name=ms_since_mn,json=msSinceMn,proto3" json:"ms_since_mn,omitempty"`
+	Snr              int32   `protobuf:"zigzag32,3,opt,name=snr,proto3" json:"snr,omitempty"`
+	DeltaTime        float64 `protobuf:"fixed64,4,opt,name=delta_time,json=deltaTime,proto3" json:"delta_time,omitempty"`
+	DeltaFrequencyHz uint32  `protobuf:"varint,5,opt,name=delta_frequency_hz,json=deltaFrequencyHz,proto3" json:"delta_frequency_hz,omitempty"`
+	Mode             string  `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	Message          string  `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	LowConfidence    bool    `protobuf:"varint,8,opt,name=low_confidence,json=lowConfidence,proto3" json:"low_confidence,omitempty"`
+	Modifiers        uint32  `protobuf:"varint,9,opt,name=modifiers,proto3" json:"modifiers,omitempty"`
+}
+
+func (x *ReplyMessage) Reset() {
+	*x = ReplyMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplyMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyMessage) ProtoMessage() {}
+
+func (x *ReplyMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyMessage.ProtoReflect.Descriptor instead.
+func (*ReplyMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplyMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplyMessage) GetMsSinceMn() uint32 {
+	if x != nil {
+		return x.MsSinceMn
+	}
+	return 0
+}
+
+func (x *ReplyMessage) GetSnr() int32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *ReplyMessage) GetDeltaTime() float64 {
+	if x != nil {
+		return x.DeltaTime
+	}
+	return 0
+}
+
+func (x *ReplyMessage) GetDeltaFrequencyHz() uint32 {
+	if x != nil {
+		return x.DeltaFrequencyHz
+	}
+	return 0
+}
+
+func (x *ReplyMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ReplyMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplyMessage) GetLowConfidence() bool {
+	if x != nil {
+		return x.LowConfidence
+	}
+	return false
+}
+
+func (x *ReplyMessage) GetModifiers() uint32 {
+	if x != nil {
+		return x.Modifiers
+	}
+	return 0
+}
+
+type QSOLoggedMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateAndTimeOff      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_and_time_off,json=dateAndTimeOff,proto3" json:"date_and_time_off,omitempty"`
+	DxCall              string                 `protobuf:"bytes,3,opt,name=dx_call,json=dxCall,proto3" json:"dx_call,omitempty"`
+	DxGrid              string                 `protobuf:"bytes,4,opt,name=dx_grid,json=dxGrid,proto3" json:"dx_grid,omitempty"`
+	TxFrequencyHz       uint64                 `protobuf:"varint,5,opt,name=tx_frequency_hz,json=txFrequencyHz,proto3" json:"tx_frequency_hz,omitempty"`
+	Mode                string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	ReportSent          string                 `protobuf:"bytes,7,opt,name=report_sent,json=reportSent,proto3" json:"report_sent,omitempty"`
+	ReportReceived      string                 `protobuf:"bytes,8,opt,name=report_received,json=reportReceived,proto3" json:"report_received,omitempty"`
+	TxPower             string                 `protobuf:"bytes,9,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
+	Comments            string                 `protobuf:"bytes,10,opt,name=comments,proto3" json:"comments,omitempty"`
+	Name                string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	DateAndTimeOn       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=date_and_time_on,json=dateAndTimeOn,proto3" json:"date_and_time_on,omitempty"`
+	OperatorCall        string                 `protobuf:"bytes,13,opt,name=operator_call,json=operatorCall,proto3" json:"operator_call,omitempty"`
+	MyCall              string                 `protobuf:"bytes,14,opt,name=my_call,json=myCall,proto3" json:"my_call,omitempty"`
+	MyGrid              string                 `protobuf:"bytes,15,opt,name=my_grid,json=myGrid,proto3" json:"my_grid,omitempty"`
+	ExchangeSent        string                 `protobuf:"bytes,16,opt,name=exchange_sent,json=exchangeSent,proto3" json:"exchange_sent,omitempty"`
+	ExchangeReceived    string                 `protobuf:"bytes,17,opt,name=exchange_received,json=exchangeReceived,proto3" json:"exchange_received,omitempty"`
+	AdifPropagationMode string                 `protobuf:"bytes,18,opt,name=adif_propagation_mode,json=adifPropagationMode,proto3" json:"adif_propagation_mode,omitempty"`
+}
+
+func (x *QSOLoggedMessage) Reset() {
+	*x = QSOLoggedMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QSOLoggedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QSOLoggedMessage) ProtoMessage() {}
+
+func (x *QSOLoggedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QSOLoggedMessage.ProtoReflect.Descriptor instead.
+func (*QSOLoggedMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QSOLoggedMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetDateAndTimeOff() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAndTimeOff
+	}
+	return nil
+}
+
+func (x *QSOLoggedMessage) GetDxCall() string {
+	if x != nil {
+		return x.DxCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetDxGrid() string {
+	if x != nil {
+		return x.DxGrid
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetTxFrequencyHz() uint64 {
+	if x != nil {
+		return x.TxFrequencyHz
+	}
+	return 0
+}
+
+func (x *QSOLoggedMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetReportSent() string {
+	if x != nil {
+		return x.ReportSent
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetReportReceived() string {
+	if x != nil {
+		return x.ReportReceived
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetTxPower() string {
+	if x != nil {
+		return x.TxPower
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetComments() string {
+	if x != nil {
+		return x.Comments
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetDateAndTimeOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateAndTimeOn
+	}
+	return nil
+}
+
+func (x *QSOLoggedMessage) GetOperatorCall() string {
+	if x != nil {
+		return x.OperatorCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetMyCall() string {
+	if x != nil {
+		return x.MyCall
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetMyGrid() string {
+	if x != nil {
+		return x.MyGrid
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetExchangeSent() string {
+	if x != nil {
+		return x.ExchangeSent
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetExchangeReceived() string {
+	if x != nil {
+		return x.ExchangeReceived
+	}
+	return ""
+}
+
+func (x *QSOLoggedMessage) GetAdifPropagationMode() string {
+	if x != nil {
+		return x.AdifPropagationMode
+	}
+	return ""
+}
+
+type CloseMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CloseMessage) Reset() {
+	*x = CloseMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseMessage) ProtoMessage() {}
+
+func (x *CloseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseMessage.ProtoReflect.Descriptor instead.
+func (*CloseMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CloseMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReplayMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplayMessage) Reset() {
+	*x = ReplayMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayMessage) ProtoMessage() {}
+
+func (x *ReplayMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayMessage.ProtoReflect.Descriptor instead.
+func (*ReplayMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReplayMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type HaltTXMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Auto bool   `protobuf:"varint,2,opt,name=auto,proto3" json:"auto,omitempty"`
+}
+
+func (x *HaltTXMessage) Reset() {
+	*x = HaltTXMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HaltTXMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HaltTXMessage) ProtoMessage() {}
+
+func (x *HaltTXMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HaltTXMessage.ProtoReflect.Descriptor instead.
+func (*HaltTXMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *HaltTXMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HaltTXMessage) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
+type FreeTextMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Send bool   `protobuf:"varint,3,opt,name=send,proto3" json:"send,omitempty"`
+}
+
+func (x *FreeTextMessage) Reset() {
+	*x = FreeTextMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeTextMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeTextMessage) ProtoMessage() {}
+
+func (x *FreeTextMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeTextMessage.ProtoReflect.Descriptor instead.
+func (*FreeTextMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FreeTextMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FreeTextMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *FreeTextMessage) GetSend() bool {
+	if x != nil {
+		return x.Send
+	}
+	return false
+}
+
+type WSPRDecodeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	New         bool                   `protobuf:"varint,2,opt,name=new,proto3" json:"new,omitempty"`
+	Time        uint32                 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	FullTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=full_time,json=fullTime,proto3" json:"full_time,omitempty"`
+	Snr         int32                  `protobuf:"zigzag32,5,opt,name=snr,proto3" json:"snr,omitempty"`
+	DeltaTime   float64                `protobuf:"fixed64,6,opt,name=delta_time,json=deltaTime,proto3" json:"delta_time,omitempty"`
+	FrequencyHz uint64                 `protobuf:"varint,7,opt,name=frequency_hz,json=frequencyHz,proto3" json:"frequency_hz,omitempty"`
+	DriftHz     int32                  `protobuf:"zigzag32,8,opt,name=drift_hz,json=driftHz,proto3" json:"drift_hz,omitempty"`
+	Callsign    string                 `protobuf:"bytes,9,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	Grid        string                 `protobuf:"bytes,10,opt,name=grid,proto3" json:"grid,omitempty"`
+	PowerDbm    int32                  `protobuf:"zigzag32,11,opt,name=power_dbm,json=powerDbm,proto3" json:"power_dbm,omitempty"`
+	PowerWatts  float64                `protobuf:"fixed64,12,opt,name=power_watts,json=powerWatts,proto3" json:"power_watts,omitempty"`
+	OffAir      bool                   `protobuf:"varint,13,opt,name=off_air,json=offAir,proto3" json:"off_air,omitempty"`
+}
+
+func (x *WSPRDecodeMessage) Reset() {
+	*x = WSPRDecodeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WSPRDecodeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WSPRDecodeMessage) ProtoMessage() {}
+
+func (x *WSPRDecodeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WSPRDecodeMessage.ProtoReflect.Descriptor instead.
+func (*WSPRDecodeMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WSPRDecodeMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WSPRDecodeMessage) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *WSPRDecodeMessage) GetTime() uint32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetFullTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FullTime
+	}
+	return nil
+}
+
+func (x *WSPRDecodeMessage) GetSnr() int32 {
+	if x != nil {
+		return x.Snr
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetDeltaTime() float64 {
+	if x != nil {
+		return x.DeltaTime
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetFrequencyHz() uint64 {
+	if x != nil {
+		return x.FrequencyHz
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetDriftHz() int32 {
+	if x != nil {
+		return x.DriftHz
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetCallsign() string {
+	if x != nil {
+		return x.Callsign
+	}
+	return ""
+}
+
+func (x *WSPRDecodeMessage) GetGrid() string {
+	if x != nil {
+		return x.Grid
+	}
+	return ""
+}
+
+func (x *WSPRDecodeMessage) GetPowerDbm() int32 {
+	if x != nil {
+		return x.PowerDbm
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetPowerWatts() float64 {
+	if x != nil {
+		return x.PowerWatts
+	}
+	return 0
+}
+
+func (x *WSPRDecodeMessage) GetOffAir() bool {
+	if x != nil {
+		return x.OffAir
+	}
+	return false
+}
+
+type LocationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+}
+
+func (x *LocationMessage) Reset() {
+	*x = LocationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationMessage) ProtoMessage() {}
+
+func (x *LocationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationMessage.ProtoReflect.Descriptor instead.
+func (*LocationMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LocationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LocationMessage) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type HighlightCallsignMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Callsign        string  `protobuf:"bytes,2,opt,name=callsign,proto3" json:"callsign,omitempty"`
+	BackgroundColor *QColor `protobuf:"bytes,3,opt,name=background_color,json=backgroundColor,proto3" json:"background_color,omitempty"`
+	ForegroundColor *QColor `protobuf:"bytes,4,opt,name=foreground_color,json=foregroundColor,proto3" json:"foreground_color,omitempty"`
+	HighlightLast   bool    `protobuf:"varint,5,opt,name=highlight_last,json=highlightLast,proto3" json:"highlight_last,omitempty"`
+}
+
+func (x *HighlightCallsignMessage) Reset() {
+	*x = HighlightCallsignMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighlightCallsignMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighlightCallsignMessage) ProtoMessage() {}
+
+func (x *HighlightCallsignMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighlightCallsignMessage.ProtoReflect.Descriptor instead.
+func (*HighlightCallsignMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HighlightCallsignMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HighlightCallsignMessage) GetCallsign() string {
+	if x != nil {
+		return x.Callsign
+	}
+	return ""
+}
+
+func (x *HighlightCallsignMessage) GetBackgroundColor() *QColor {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return nil
+}
+
+func (x *HighlightCallsignMessage) GetForegroundColor() *QColor {
+	if x != nil {
+		return x.ForegroundColor
+	}
+	return nil
+}
+
+func (x *HighlightCallsignMessage) GetHighlightLast() bool {
+	if x != nil {
+		return x.HighlightLast
+	}
+	return false
+}
+
+// QColor has 16 bit channels; alpha 0 clears a highlight.
+type QColor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alpha uint32 `protobuf:"varint,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Red   uint32 `protobuf:"varint,2,opt,name=red,proto3" json:"red,omitempty"`
+	Green uint32 `protobuf:"varint,3,opt,name=green,proto3" json:"green,omitempty"`
+	Blue  uint32 `protobuf:"varint,4,opt,name=blue,proto3" json:"blue,omitempty"`
+}
+
+func (x *QColor) Reset() {
+	*x = QColor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QColor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QColor) ProtoMessage() {}
+
+func (x *QColor) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QColor.ProtoReflect.Descriptor instead.
+func (*QColor) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QColor) GetAlpha() uint32 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *QColor) GetRed() uint32 {
+	if x != nil {
+		return x.Red
+	}
+	return 0
+}
+
+func (x *QColor) GetGreen() uint32 {
+	if x != nil {
+		return x.Green
+	}
+	return 0
+}
+
+func (x *QColor) GetBlue() uint32 {
+	if x != nil {
+		return x.Blue
+	}
+	return 0
+}
+
+type SwitchConfigurationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConfigurationName string `protobuf:"bytes,2,opt,name=configuration_name,json=configurationName,proto3" json:"configuration_name,omitempty"`
+}
+
+func (x *SwitchConfigurationMessage) Reset() {
+	*x = SwitchConfigurationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchConfigurationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchConfigurationMessage) ProtoMessage() {}
+
+func (x *SwitchConfigurationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchConfigurationMessage.ProtoReflect.Descriptor instead.
+func (*SwitchConfigurationMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SwitchConfigurationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SwitchConfigurationMessage) GetConfigurationName() string {
+	if x != nil {
+		return x.ConfigurationName
+	}
+	return ""
+}
+
+type ConfigurationMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Mode               string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	FrequencyTolerance uint32 `protobuf:"varint,3,opt,name=frequency_tolerance,json=frequencyTolerance,proto3" json:"frequency_tolerance,omitempty"`
+	Submode            string `protobuf:"bytes,4,opt,name=submode,proto3" json:"submode,omitempty"`
+	FastMode           bool   `protobuf:"varint,5,opt,name=fast_mode,json=fastMode,proto3" json:"fast_mode,omitempty"`
+	TrPeriod           uint32 `protobuf:"varint,6,opt,name=tr_period,json=trPeriod,proto3" json:"tr_period,omitempty"`
+	RxDf               uint32 `protobuf:"varint,7,opt,name=rx_df,json=rxDf,proto3" json:"rx_df,omitempty"`
+	DxCall             string `protobuf:"bytes,8,opt,name=dx_call,json=dxCall,proto3" json:"dx_call,omitempty"`
+	DxGrid             string `protobuf:"bytes,9,opt,name=dx_grid,json=dxGrid,proto3" json:"dx_grid,omitempty"`
+	GenerateMessage    bool   `protobuf:"varint,10,opt,name=generate_message,json=generateMessage,proto3" json:"generate_message,omitempty"`
+}
+
+func (x *ConfigurationMessage) Reset() {
+	*x = ConfigurationMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wsjtx_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationMessage) ProtoMessage() {}
+
+func (x *ConfigurationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_wsjtx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationMessage.ProtoReflect.Descriptor instead.
+func (*ConfigurationMessage) Descriptor() ([]byte, []int) {
+	return file_wsjtx_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ConfigurationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ConfigurationMessage) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ConfigurationMessage) GetFrequencyTolerance() uint32 {
+	if x != nil {
+		return x.FrequencyTolerance
+	}
+	return 0
+}
+
+func (x *ConfigurationMessage) GetSubmode() string {
+	if x != nil {
+		return x.Submode
+	}
+	return ""
+}
+
+func (x *ConfigurationMessage) GetFastMode() bool {
+	if x != nil {
+		return x.FastMode
+	}
+	return false
+}
+
+func (x *ConfigurationMessage) GetTrPeriod() uint32 {
+	if x != nil {
+		return x.TrPeriod
+	}
+	return 0
+}
+
+func (x *ConfigurationMessage) GetRxDf() uint32 {
+	if x != nil {
+		return x.RxDf
+	}
+	return 0
+}
+
+func (x *ConfigurationMessage) GetDxCall() string {
+	if x != nil {
+		return x.DxCall
+	}
+	return ""
+}
+
+func (x *ConfigurationMessage) GetDxGrid() string {
+	if x != nil {
+		return x.DxGrid
+	}
+	return ""
+}
+
+func (x *ConfigurationMessage) GetGenerateMessage() bool {
+	if x != nil {
+		return x.GenerateMessage
+	}
+	return false
+}
+
+var File_wsjtx_proto protoreflect.FileDescriptor
+
+var file_wsjtx_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x77,
+	0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x69, 0x67, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x69, 0x67, 0x6e, 0x22, 0xd7, 0x04, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x09, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x68,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x06,
+	0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
+	0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x12, 0x3c, 0x0a, 0x0a, 0x71, 0x73, 0x6f, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x53, 0x4f, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x09, 0x71, 0x73, 0x6f, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x12,
+	0x2f, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0b, 0x77, 0x73, 0x70, 0x72, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x53, 0x50, 0x52, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x77, 0x73, 0x70, 0x72, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x69, 0x66,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x41, 0x44, 0x49, 0x46, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x41, 0x64,
+	0x69, 0x66, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0x89, 0x03, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x02, 0x75, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e,
+	0x12, 0x37, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x11, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x85, 0x01, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x05, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x69, 0x61, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x78, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x78, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x0a, 0x05, 0x72,
+	0x78, 0x5f, 0x64, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x78, 0x44, 0x66,
+	0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x64, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x74, 0x78, 0x44, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x47, 0x72, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x67, 0x72,
+	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x47, 0x72, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f, 0x67, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f,
+	0x67, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6c,
+	0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x0e,
+	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6e, 0x65, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x6e, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c,
+	0x0a, 0x12, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x68, 0x7a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f,
+	0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x41, 0x69, 0x72, 0x22, 0x39, 0x0a, 0x0d, 0x43, 0x6c,
+	0x65, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x8f, 0x05, 0x0a, 0x11, 0x51, 0x53, 0x4f, 0x4c, 0x6f, 0x67,
+	0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x11, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x66, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x78, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78,
+	0x47, 0x72, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x78, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
+	0x78, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x79, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x79, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79, 0x5f, 0x67,
+	0x72, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x79, 0x47, 0x72, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x69, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x69, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x1f, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x12, 0x57, 0x53, 0x50,
+	0x52, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6e, 0x65,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x6e, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x48, 0x7a, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x68, 0x7a, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x48, 0x7a, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x62, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x11,
+	0x52, 0x08, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x62, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x77, 0x61, 0x74, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x61, 0x74, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x66, 0x66,
+	0x5f, 0x61, 0x69, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x41,
+	0x69, 0x72, 0x22, 0x38, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x41, 0x44, 0x49, 0x46,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x69, 0x66,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x69, 0x66, 0x22, 0x84, 0x01, 0x0a,
+	0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x90, 0x05, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x64, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x78, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x0a, 0x05, 0x72, 0x78, 0x5f, 0x64, 0x66, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x78, 0x44, 0x66, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x64, 0x66, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x78, 0x44, 0x66,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x65, 0x5f,
+	0x67, 0x72, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x47, 0x72,
+	0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x47, 0x72, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x78, 0x5f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x74, 0x78, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f, 0x67, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x73, 0x74, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x5f,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x72, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x74, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcb, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x66,
+	0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x5f, 0x61, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x41, 0x69, 0x72, 0x22, 0x38, 0x0a, 0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x90,
+	0x02, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1e, 0x0a, 0x0b, 0x6d, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x4d, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x6e,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x22, 0x8e, 0x05, 0x0a, 0x10, 0x51, 0x53, 0x4f, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x67, 0x72, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x47, 0x72, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x78, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x68, 0x7a, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x78, 0x46, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x43, 0x0a, 0x10, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x4f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x79, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x79, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x79, 0x47, 0x72, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x61, 0x64, 0x69, 0x66, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61,
+	0x64, 0x69, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f,
+	0x64, 0x65, 0x22, 0x1e, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x1f, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0d, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x58, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x46, 0x72, 0x65, 0x65,
+	0x54, 0x65, 0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73,
+	0x65, 0x6e, 0x64, 0x22, 0xf8, 0x02, 0x0a, 0x11, 0x57, 0x53, 0x50, 0x52, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x66, 0x75, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6e, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x73, 0x6e, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x68, 0x7a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x7a, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x68, 0x7a, 0x18, 0x08, 0x20, 0x01, 0x28, 0x11, 0x52, 0x07,
+	0x64, 0x72, 0x69, 0x66, 0x74, 0x48, 0x7a, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x69, 0x67, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x73,
+	0x69, 0x67, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x64, 0x62, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x11, 0x52, 0x08, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x44, 0x62, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x61,
+	0x74, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x57, 0x61, 0x74, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x69, 0x72,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x41, 0x69, 0x72, 0x22, 0x3d,
+	0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x01,
+	0x0a, 0x18, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73,
+	0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x3b, 0x0a, 0x10, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52,
+	0x0f, 0x66, 0x6f, 0x72, 0x65, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x06, 0x51, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x65,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x62,
+	0x6c, 0x75, 0x65, 0x22, 0x5b, 0x0a, 0x1a, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0xb1, 0x02, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a,
+	0x13, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x74, 0x6f, 0x6c, 0x65, 0x72,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x62, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x73, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x72, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x72, 0x78, 0x5f, 0x64, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x72, 0x78, 0x44, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x78, 0x43, 0x61, 0x6c, 0x6c,
+	0x12, 0x17, 0x0a, 0x07, 0x64, 0x78, 0x5f, 0x67, 0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x78, 0x47, 0x72, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x32, 0xc9, 0x06, 0x0a, 0x05, 0x57, 0x53, 0x4a, 0x54, 0x58, 0x12, 0x46,
+	0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1f, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x16, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x58, 0x12, 0x17,
+	0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6c, 0x74, 0x54, 0x58,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x19,
+	0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x65,
+	0x78, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x16, 0x2e,
+	0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x17, 0x2e, 0x77, 0x73, 0x6a,
+	0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11,
+	0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x69, 0x67,
+	0x6e, 0x12, 0x22, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x56, 0x0a, 0x13, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e,
+	0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x19, 0x2e, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x6f, 0x67, 0x6f, 0x63, 0x6f, 0x6d, 0x75, 0x6e, 0x65, 0x2f, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x61, 0x70, 0x69, 0x2f, 0x77, 0x73, 0x6a, 0x74, 0x78, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_wsjtx_proto_rawDescOnce sync.Once
+	file_wsjtx_proto_rawDescData = file_wsjtx_proto_rawDesc
+)
+
+func file_wsjtx_proto_rawDescGZIP() []byte {
+	file_wsjtx_proto_rawDescOnce.Do(func() {
+		file_wsjtx_proto_rawDescData = protoimpl.X.CompressGZIP(file_wsjtx_proto_rawDescData)
+	})
+	return file_wsjtx_proto_rawDescData
+}
+
+var file_wsjtx_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_wsjtx_proto_goTypes = []interface{}{
+	(*StreamMessagesRequest)(nil),      // 0: wsjtx.v1.StreamMessagesRequest
+	(*Message)(nil),                    // 1: wsjtx.v1.Message
+	(*ListInstancesRequest)(nil),       // 2: wsjtx.v1.ListInstancesRequest
+	(*ListInstancesResponse)(nil),      // 3: wsjtx.v1.ListInstancesResponse
+	(*Instance)(nil),                   // 4: wsjtx.v1.Instance
+	(*CommandResponse)(nil),            // 5: wsjtx.v1.CommandResponse
+	(*HeartbeatResponse)(nil),          // 6: wsjtx.v1.HeartbeatResponse
+	(*StatusResponse)(nil),             // 7: wsjtx.v1.StatusResponse
+	(*DecodeResponse)(nil),             // 8: wsjtx.v1.DecodeResponse
+	(*ClearResponse)(nil),              // 9: wsjtx.v1.ClearResponse
+	(*QSOLoggedResponse)(nil),          // 10: wsjtx.v1.QSOLoggedResponse
+	(*CloseResponse)(nil),              // 11: wsjtx.v1.CloseResponse
+	(*WSPRDecodeResponse)(nil),         // 12: wsjtx.v1.WSPRDecodeResponse
+	(*LoggedADIFResponse)(nil),         // 13: wsjtx.v1.LoggedADIFResponse
+	(*HeartbeatMessage)(nil),           // 14: wsjtx.v1.HeartbeatMessage
+	(*StatusMessage)(nil),              // 15: wsjtx.v1.StatusMessage
+	(*DecodeMessage)(nil),              // 16: wsjtx.v1.DecodeMessage
+	(*ClearMessage)(nil),               // 17: wsjtx.v1.ClearMessage
+	(*ReplyMessage)(nil),               // 18: wsjtx.v1.ReplyMessage
+	(*QSOLoggedMessage)(nil),           // 19: wsjtx.v1.QSOLoggedMessage
+	(*CloseMessage)(nil),               // 20: wsjtx.v1.CloseMessage
+	(*ReplayMessage)(nil),              // 21: wsjtx.v1.ReplayMessage
+	(*HaltTXMessage)(nil),              // 22: wsjtx.v1.HaltTXMessage
+	(*FreeTextMessage)(nil),            // 23: wsjtx.v1.FreeTextMessage
+	(*WSPRDecodeMessage)(nil),          // 24: wsjtx.v1.WSPRDecodeMessage
+	(*LocationMessage)(nil),            // 25: wsjtx.v1.LocationMessage
+	(*HighlightCallsignMessage)(nil),   // 26: wsjtx.v1.HighlightCallsignMessage
+	(*QColor)(nil),                     // 27: wsjtx.v1.QColor
+	(*SwitchConfigurationMessage)(nil), // 28: wsjtx.v1.SwitchConfigurationMessage
+	(*ConfigurationMessage)(nil),       // 29: wsjtx.v1.ConfigurationMessage
+	(*timestamppb.Timestamp)(nil),      // 30: google.protobuf.Timestamp
+}
+var file_wsjtx_proto_depIdxs = []int32{
+	30, // 0: wsjtx.v1.Message.received:type_name -> google.protobuf.Timestamp
+	6,  // 1: wsjtx.v1.Message.heartbeat:type_name -> wsjtx.v1.HeartbeatResponse
+	7,  // 2: wsjtx.v1.Message.status:type_name -> wsjtx.v1.StatusResponse
+	8,  // 3: wsjtx.v1.Message.decode:type_name -> wsjtx.v1.DecodeResponse
+	9,  // 4: wsjtx.v1.Message.clear:type_name -> wsjtx.v1.ClearResponse
+	10, // 5: wsjtx.v1.Message.qso_logged:type_name -> wsjtx.v1.QSOLoggedResponse
+	11, // 6: wsjtx.v1.Message.close:type_name -> wsjtx.v1.CloseResponse
+	12, // 7: wsjtx.v1.Message.wspr_decode:type_name -> wsjtx.v1.WSPRDecodeResponse
+	13, // 8: wsjtx.v1.Message.logged_adif:type_name -> wsjtx.v1.LoggedADIFResponse
+	4,  // 9: wsjtx.v1.ListInstancesResponse.instances:type_name -> wsjtx.v1.Instance
+	7,  // 10: wsjtx.v1.Instance.status:type_name -> wsjtx.v1.StatusResponse
+	30, // 11: wsjtx.v1.Instance.first_seen:type_name -> google.protobuf.Timestamp
+	30, // 12: wsjtx.v1.Instance.last_seen:type_name -> google.protobuf.Timestamp
+	30, // 13: wsjtx.v1.Instance.last_heartbeat:type_name -> google.protobuf.Timestamp
+	30, // 14: wsjtx.v1.DecodeResponse.full_time:type_name -> google.protobuf.Timestamp
+	30, // 15: wsjtx.v1.QSOLoggedResponse.date_and_time_off:type_name -> google.protobuf.Timestamp
+	30, // 16: wsjtx.v1.QSOLoggedResponse.date_and_time_on:type_name -> google.protobuf.Timestamp
+	30, // 17: wsjtx.v1.WSPRDecodeResponse.full_time:type_name -> google.protobuf.Timestamp
+	30, // 18: wsjtx.v1.DecodeMessage.full_time:type_name -> google.protobuf.Timestamp
+	30, // 19: wsjtx.v1.QSOLoggedMessage.date_and_time_off:type_name -> google.protobuf.Timestamp
+	30, // 20: wsjtx.v1.QSOLoggedMessage.date_and_time_on:type_name -> google.protobuf.Timestamp
+	30, // 21: wsjtx.v1.WSPRDecodeMessage.full_time:type_name -> google.protobuf.Timestamp
+	27, // 22: wsjtx.v1.HighlightCallsignMessage.background_color:type_name -> wsjtx.v1.QColor
+	27, // 23: wsjtx.v1.HighlightCallsignMessage.foreground_color:type_name -> wsjtx.v1.QColor
+	0,  // 24: wsjtx.v1.WSJTX.StreamMessages:input_type -> wsjtx.v1.StreamMessagesRequest
+	2,  // 25: wsjtx.v1.WSJTX.ListInstances:input_type -> wsjtx.v1.ListInstancesRequest
+	18, // 26: wsjtx.v1.WSJTX.Reply:input_type -> wsjtx.v1.ReplyMessage
+	22, // 27: wsjtx.v1.WSJTX.HaltTX:input_type -> wsjtx.v1.HaltTXMessage
+	23, // 28: wsjtx.v1.WSJTX.FreeText:input_type -> wsjtx.v1.FreeTextMessage
+	17, // 29: wsjtx.v1.WSJTX.Clear:input_type -> wsjtx.v1.ClearMessage
+	21, // 30: wsjtx.v1.WSJTX.Replay:input_type -> wsjtx.v1.ReplayMessage
+	20, // 31: wsjtx.v1.WSJTX.Close:input_type -> wsjtx.v1.CloseMessage
+	25, // 32: wsjtx.v1.WSJTX.Location:input_type -> wsjtx.v1.LocationMessage
+	26, // 33: wsjtx.v1.WSJTX.HighlightCallsign:input_type -> wsjtx.v1.HighlightCallsignMessage
+	28, // 34: wsjtx.v1.WSJTX.SwitchConfiguration:input_type -> wsjtx.v1.SwitchConfigurationMessage
+	29, // 35: wsjtx.v1.WSJTX.Configure:input_type -> wsjtx.v1.ConfigurationMessage
+	1,  // 36: wsjtx.v1.WSJTX.StreamMessages:output_type -> wsjtx.v1.Message
+	3,  // 37: wsjtx.v1.WSJTX.ListInstances:output_type -> wsjtx.v1.ListInstancesResponse
+	5,  // 38: wsjtx.v1.WSJTX.Reply:output_type -> wsjtx.v1.CommandResponse
+	5,  // 39: wsjtx.v1.WSJTX.HaltTX:output_type -> wsjtx.v1.CommandResponse
+	5,  // 40: wsjtx.v1.WSJTX.FreeText:output_type -> wsjtx.v1.CommandResponse
+	5,  // 41: wsjtx.v1.WSJTX.Clear:output_type -> wsjtx.v1.CommandResponse
+	5,  // 42: wsjtx.v1.WSJTX.Replay:output_type -> wsjtx.v1.CommandResponse
+	5,  // 43: wsjtx.v1.WSJTX.Close:output_type -> wsjtx.v1.CommandResponse
+	5,  // 44: wsjtx.v1.WSJTX.Location:output_type -> wsjtx.v1.CommandResponse
+	5,  // 45: wsjtx.v1.WSJTX.HighlightCallsign:output_type -> wsjtx.v1.CommandResponse
+	5,  // 46: wsjtx.v1.WSJTX.SwitchConfiguration:output_type -> wsjtx.v1.CommandResponse
+	5,  // 47: wsjtx.v1.WSJTX.Configure:output_type -> wsjtx.v1.CommandResponse
+	36, // [36:48] is the sub-list for method output_type
+	24, // [24:36] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
+}
+
+func init() { file_wsjtx_proto_init() }
+func file_wsjtx_proto_init() {
+	if File_wsjtx_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_wsjtx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Instance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QSOLoggedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WSPRDecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggedADIFResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplyMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QSOLoggedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HaltTXMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeTextMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WSPRDecodeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighlightCallsignMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QColor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchConfigurationMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wsjtx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_wsjtx_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*Message_Heartbeat)(nil),
+		(*Message_Status)(nil),
+		(*Message_Decode)(nil),
+		(*Message_Clear)(nil),
+		(*Message_QsoLogged)(nil),
+		(*Message_Close)(nil),
+		(*Message_WsprDecode)(nil),
+		(*Message_LoggedAdif)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_wsjtx_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wsjtx_proto_goTypes,
+		DependencyIndexes: file_wsjtx_proto_depIdxs,
+		MessageInfos:      file_wsjtx_proto_msgTypes,
+	}.Build()
+	File_wsjtx_proto = out.File
+	file_wsjtx_proto_rawDesc = nil
+	file_wsjtx_proto_goTypes = nil
+	file_wsjtx_proto_depIdxs = nil
+}
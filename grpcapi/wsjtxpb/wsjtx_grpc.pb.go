@@ -0,0 +1,535 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: wsjtx.proto
+
+package wsjtxpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// WSJTXClient is the client API for WSJTX service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WSJTXClient interface {
+	// StreamMessages streams the messages matching the request until the client cancels.
+	StreamMessages(ctx context.Context, in *StreamMessagesRequest, opts ...grpc.CallOption) (WSJTX_StreamMessagesClient, error)
+	// ListInstances returns the known instances.
+	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
+	// The commands are sent to the instance named by their id.
+	Reply(ctx context.Context, in *ReplyMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	HaltTX(ctx context.Context, in *HaltTXMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	FreeText(ctx context.Context, in *FreeTextMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	Clear(ctx context.Context, in *ClearMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	Replay(ctx context.Context, in *ReplayMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	Close(ctx context.Context, in *CloseMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	Location(ctx context.Context, in *LocationMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	HighlightCallsign(ctx context.Context, in *HighlightCallsignMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	SwitchConfiguration(ctx context.Context, in *SwitchConfigurationMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+	Configure(ctx context.Context, in *ConfigurationMessage, opts ...grpc.CallOption) (*CommandResponse, error)
+}
+
+type wSJTXClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWSJTXClient(cc grpc.ClientConnInterface) WSJTXClient {
+	return &wSJTXClient{cc}
+}
+
+func (c *wSJTXClient) StreamMessages(ctx context.Context, in *StreamMessagesRequest, opts ...grpc.CallOption) (WSJTX_StreamMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WSJTX_ServiceDesc.Streams[0], "/wsjtx.v1.WSJTX/StreamMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &wSJTXStreamMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WSJTX_StreamMessagesClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type wSJTXStreamMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *wSJTXStreamMessagesClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *wSJTXClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error) {
+	out := new(ListInstancesResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/ListInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Reply(ctx context.Context, in *ReplyMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Reply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) HaltTX(ctx context.Context, in *HaltTXMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/HaltTX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) FreeText(ctx context.Context, in *FreeTextMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/FreeText", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Clear(ctx context.Context, in *ClearMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Clear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Replay(ctx context.Context, in *ReplayMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Replay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Close(ctx context.Context, in *CloseMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Location(ctx context.Context, in *LocationMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Location", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) HighlightCallsign(ctx context.Context, in *HighlightCallsignMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/HighlightCallsign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) SwitchConfiguration(ctx context.Context, in *SwitchConfigurationMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/SwitchConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *wSJTXClient) Configure(ctx context.Context, in *ConfigurationMessage, opts ...grpc.CallOption) (*CommandResponse, error) {
+	out := new(CommandResponse)
+	err := c.cc.Invoke(ctx, "/wsjtx.v1.WSJTX/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WSJTXServer is the server API for WSJTX service.
+// All implementations must embed UnimplementedWSJTXServer
+// for forward compatibility
+type WSJTXServer interface {
+	// StreamMessages streams the messages matching the request until the client cancels.
+	StreamMessages(*StreamMessagesRequest, WSJTX_StreamMessagesServer) error
+	// ListInstances returns the known instances.
+	ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error)
+	// The commands are sent to the instance named by their id.
+	Reply(context.Context, *ReplyMessage) (*CommandResponse, error)
+	HaltTX(context.Context, *HaltTXMessage) (*CommandResponse, error)
+	FreeText(context.Context, *FreeTextMessage) (*CommandResponse, error)
+	Clear(context.Context, *ClearMessage) (*CommandResponse, error)
+	Replay(context.Context, *ReplayMessage) (*CommandResponse, error)
+	Close(context.Context, *CloseMessage) (*CommandResponse, error)
+	Location(context.Context, *LocationMessage) (*CommandResponse, error)
+	HighlightCallsign(context.Context, *HighlightCallsignMessage) (*CommandResponse, error)
+	SwitchConfiguration(context.Context, *SwitchConfigurationMessage) (*CommandResponse, error)
+	Configure(context.Context, *ConfigurationMessage) (*CommandResponse, error)
+	mustEmbedUnimplementedWSJTXServer()
+}
+
+// UnimplementedWSJTXServer must be embedded to have forward compatible implementations.
+type UnimplementedWSJTXServer struct {
+}
+
+func (UnimplementedWSJTXServer) StreamMessages(*StreamMessagesRequest, WSJTX_StreamMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMessages not implemented")
+}
+func (UnimplementedWSJTXServer) ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedWSJTXServer) Reply(context.Context, *ReplyMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reply not implemented")
+}
+func (UnimplementedWSJTXServer) HaltTX(context.Context, *HaltTXMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltTX not implemented")
+}
+func (UnimplementedWSJTXServer) FreeText(context.Context, *FreeTextMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeText not implemented")
+}
+func (UnimplementedWSJTXServer) Clear(context.Context, *ClearMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (UnimplementedWSJTXServer) Replay(context.Context, *ReplayMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replay not implemented")
+}
+func (UnimplementedWSJTXServer) Close(context.Context, *CloseMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedWSJTXServer) Location(context.Context, *LocationMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Location not implemented")
+}
+func (UnimplementedWSJTXServer) HighlightCallsign(context.Context, *HighlightCallsignMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HighlightCallsign not implemented")
+}
+func (UnimplementedWSJTXServer) SwitchConfiguration(context.Context, *SwitchConfigurationMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchConfiguration not implemented")
+}
+func (UnimplementedWSJTXServer) Configure(context.Context, *ConfigurationMessage) (*CommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (UnimplementedWSJTXServer) mustEmbedUnimplementedWSJTXServer() {}
+
+// UnsafeWSJTXServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WSJTXServer will
+// result in compilation errors.
+type UnsafeWSJTXServer interface {
+	mustEmbedUnimplementedWSJTXServer()
+}
+
+func RegisterWSJTXServer(s grpc.ServiceRegistrar, srv WSJTXServer) {
+	s.RegisterService(&WSJTX_ServiceDesc, srv)
+}
+
+func _WSJTX_StreamMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WSJTXServer).StreamMessages(m, &wSJTXStreamMessagesServer{stream})
+}
+
+type WSJTX_StreamMessagesServer interface {
+	Send(*Message) error
+	grpc.ServerStream
+}
+
+type wSJTXStreamMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *wSJTXStreamMessagesServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WSJTX_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/ListInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).ListInstances(ctx, req.(*ListInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Reply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Reply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Reply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Reply(ctx, req.(*ReplyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_HaltTX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltTXMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).HaltTX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/HaltTX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).HaltTX(ctx, req.(*HaltTXMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_FreeText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreeTextMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).FreeText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/FreeText",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).FreeText(ctx, req.(*FreeTextMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Clear(ctx, req.(*ClearMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Replay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Replay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Replay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Replay(ctx, req.(*ReplayMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Close(ctx, req.(*CloseMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Location_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Location(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Location",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Location(ctx, req.(*LocationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_HighlightCallsign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HighlightCallsignMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).HighlightCallsign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/HighlightCallsign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).HighlightCallsign(ctx, req.(*HighlightCallsignMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_SwitchConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchConfigurationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).SwitchConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/SwitchConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).SwitchConfiguration(ctx, req.(*SwitchConfigurationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WSJTX_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WSJTXServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsjtx.v1.WSJTX/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WSJTXServer).Configure(ctx, req.(*ConfigurationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WSJTX_ServiceDesc is the grpc.ServiceDesc for WSJTX service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WSJTX_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wsjtx.v1.WSJTX",
+	HandlerType: (*WSJTXServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInstances",
+			Handler:    _WSJTX_ListInstances_Handler,
+		},
+		{
+			MethodName: "Reply",
+			Handler:    _WSJTX_Reply_Handler,
+		},
+		{
+			MethodName: "HaltTX",
+			Handler:    _WSJTX_HaltTX_Handler,
+		},
+		{
+			MethodName: "FreeText",
+			Handler:    _WSJTX_FreeText_Handler,
+		},
+		{
+			MethodName: "Clear",
+			Handler:    _WSJTX_Clear_Handler,
+		},
+		{
+			MethodName: "Replay",
+			Handler:    _WSJTX_Replay_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _WSJTX_Close_Handler,
+		},
+		{
+			MethodName: "Location",
+			Handler:    _WSJTX_Location_Handler,
+		},
+		{
+			MethodName: "HighlightCallsign",
+			Handler:    _WSJTX_HighlightCallsign_Handler,
+		},
+		{
+			MethodName: "SwitchConfiguration",
+			Handler:    _WSJTX_SwitchConfiguration_Handler,
+		},
+		{
+			MethodName: "Configure",
+			Handler:    _WSJTX_Configure_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMessages",
+			Handler:       _WSJTX_StreamMessages_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wsjtx.proto",
+}
@@ -0,0 +1,323 @@
+// Package httpapi serves a REST API over HTTP to read the state of the WSJT-X
+// instances and send them commands. The API is described by openapi.json,
+// served on /openapi.json.
+package httpapi
+
+import (
+	"context"
+	_ "embed" // openapi.json
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/command"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultRecentDecodes is the number of decodes kept per instance.
+	DefaultRecentDecodes = 200
+
+	maxBody      = 64 << 10
+	writeTimeout = 5 * time.Second
+)
+
+//go:embed openapi.json
+var openAPI []byte
+
+// commands maps the path of the POST endpoints to the command names.
+var commands = map[string]string{
+	"halt-tx":              command.HaltTX,
+	"free-text":            command.FreeText,
+	"reply":                command.Reply,
+	"clear":                command.Clear,
+	"replay":               command.Replay,
+	"location":             command.Location,
+	"highlight":            command.HighlightCallsign,
+	"switch-configuration": command.SwitchConfiguration,
+	"configure":            command.Configure,
+}
+
+// Writer sends datagrams to an address. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+// Instances lists the known instances. It is implemented by *instance.Registry.
+type Instances interface {
+	Get(id string) (instance.Instance, bool)
+	List() []instance.Instance
+}
+
+// API is the http.Handler of the REST API.
+type API struct {
+	w         Writer
+	instances Instances
+	keep      int
+	authorize func(*http.Request) bool
+	mu        sync.RWMutex
+	decodes   map[string][]message.DecodeResponse
+}
+
+// Option configures an API.
+type Option func(*API)
+
+// WithRecentDecodes sets the number of decodes kept per instance.
+func WithRecentDecodes(n int) Option {
+	return func(a *API) {
+		if n > 0 {
+			a.keep = n
+		}
+	}
+}
+
+// WithAuthorizer sets which requests may send commands. By default none can.
+func WithAuthorizer(authorize func(*http.Request) bool) Option {
+	return func(a *API) {
+		a.authorize = authorize
+	}
+}
+
+func NewAPI(w Writer, instances Instances, opts ...Option) *API {
+	a := &API{
+		w:         w,
+		instances: instances,
+		keep:      DefaultRecentDecodes,
+		authorize: func(*http.Request) bool { return false },
+		decodes:   make(map[string][]message.DecodeResponse),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+// Register keeps the recent decodes dispatched by router.
+func (a *API) Register(router *udpserver.Router) {
+	router.OnDecode(func(_ udpserver.Message, d message.DecodeResponse) {
+		a.AddDecode(d)
+	})
+}
+
+// AddDecode keeps d in the recent decodes of its instance.
+func (a *API) AddDecode(d message.DecodeResponse) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	l := append(a.decodes[d.ID], d)
+	if len(l) > a.keep {
+		l = append(l[:0:0], l[len(l)-a.keep:]...)
+	}
+
+	a.decodes[d.ID] = l
+}
+
+// Error is the body of the error responses.
+type Error struct {
+	Error string `json:"error"`
+	Field string `json:"field,omitempty"`
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == "openapi.json":
+		a.get(w, r, func() (interface{}, error) {
+			return json.RawMessage(openAPI), nil
+		})
+	case len(parts) == 1 && parts[0] == "instances":
+		a.get(w, r, func() (interface{}, error) {
+			return a.instances.List(), nil
+		})
+	case len(parts) == 2 && parts[0] == "instances":
+		a.get(w, r, func() (interface{}, error) {
+			return a.instance(parts[1])
+		})
+	case len(parts) == 3 && parts[0] == "instances" && parts[2] == "status":
+		a.get(w, r, func() (interface{}, error) {
+			return a.status(parts[1])
+		})
+	case len(parts) == 3 && parts[0] == "instances" && parts[2] == "decodes":
+		a.get(w, r, func() (interface{}, error) {
+			return a.recentDecodes(parts[1], r.URL.Query().Get("limit"))
+		})
+	case len(parts) == 3 && parts[0] == "instances" && commands[parts[2]] != "":
+		a.command(w, r, parts[1], commands[parts[2]])
+	default:
+		writeJSON(w, http.StatusNotFound, Error{Error: "not found"})
+	}
+}
+
+var (
+	errUnknownInstance = errors.New("unknown instance")
+	errNoStatus        = errors.New("no status received yet")
+	errNotAuthorized   = errors.New("not authorized to send commands")
+	errNoAddress       = errors.New("instance address unknown")
+)
+
+// badRequest is an error caused by the request.
+type badRequest struct {
+	msg   string
+	field string
+}
+
+func (e *badRequest) Error() string {
+	return e.msg
+}
+
+func (a *API) get(w http.ResponseWriter, r *http.Request, f func() (interface{}, error)) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, Error{Error: "method not allowed"})
+
+		return
+	}
+
+	v, err := f()
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (a *API) instance(id string) (instance.Instance, error) {
+	i, ok := a.instances.Get(id)
+	if !ok {
+		return i, errUnknownInstance
+	}
+
+	return i, nil
+}
+
+func (a *API) status(id string) (*message.StatusResponse, error) {
+	i, err := a.instance(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if i.Status == nil {
+		return nil, errNoStatus
+	}
+
+	return i.Status, nil
+}
+
+func (a *API) recentDecodes(id, limit string) ([]message.DecodeResponse, error) {
+	if _, err := a.instance(id); err != nil {
+		return nil, err
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	l := a.decodes[id]
+
+	if limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			return nil, &badRequest{msg: "limit must be a positive number", field: "limit"}
+		}
+
+		if n < len(l) {
+			l = l[len(l)-n:]
+		}
+	}
+
+	return append([]message.DecodeResponse{}, l...), nil
+}
+
+func (a *API) command(w http.ResponseWriter, r *http.Request, id, name string) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, Error{Error: "method not allowed"})
+
+		return
+	}
+
+	if !a.authorize(r) {
+		writeError(w, errNotAuthorized)
+
+		return
+	}
+
+	i, err := a.instance(id)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	// A nil address would send the command to the last instance heard.
+	if i.Addr == nil {
+		writeError(w, errNoAddress)
+
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBody))
+	if err != nil {
+		writeError(w, &badRequest{msg: err.Error()})
+
+		return
+	}
+
+	msg, err := command.Encode(name, id, body)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), writeTimeout)
+	defer cancel()
+
+	if err := a.w.WriteTo(ctx, msg, i.Addr); err != nil {
+		writeJSON(w, http.StatusBadGateway, Error{Error: err.Error()})
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var (
+		validation *command.ValidationError
+		bad        *badRequest
+	)
+
+	switch {
+	case errors.As(err, &validation):
+		writeJSON(w, http.StatusBadRequest, Error{Error: validation.Reason, Field: validation.Field})
+	case errors.As(err, &bad):
+		writeJSON(w, http.StatusBadRequest, Error{Error: bad.msg, Field: bad.field})
+	case errors.Is(err, errUnknownInstance), errors.Is(err, errNoStatus):
+		writeJSON(w, http.StatusNotFound, Error{Error: err.Error()})
+	case errors.Is(err, errNotAuthorized):
+		writeJSON(w, http.StatusForbidden, Error{Error: err.Error()})
+	case errors.Is(err, errNoAddress):
+		writeJSON(w, http.StatusConflict, Error{Error: err.Error()})
+	default:
+		writeJSON(w, http.StatusInternalServerError, Error{Error: err.Error()})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,96 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+)
+
+type fakeWriter struct {
+	sent [][]byte
+}
+
+func (f *fakeWriter) WriteTo(_ context.Context, msg []byte, _ *net.UDPAddr) error {
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func TestAPI(t *testing.T) {
+	registry := instance.NewRegistry(nil, log.New(io.Discard, "", 0))
+	addr := &net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 55000}
+	registry.Heartbeat(addr, message.HeartbeatResponse{ID: "WSJT-X", Version: "2.5.2"})
+	registry.Status(addr, message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8"})
+	registry.Status(nil, message.StatusResponse{ID: "MSHV", Dial: 7074000, Mode: "FT8"})
+
+	w := &fakeWriter{}
+	api := NewAPI(w, registry, WithRecentDecodes(2), WithAuthorizer(func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer secret"
+	}))
+
+	for _, text := range []string{"CQ A1AA", "CQ B1BB", "CQ C1CC"} {
+		api.AddDecode(message.DecodeResponse{ID: "WSJT-X", Message: text})
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		auth     bool
+		wantCode int
+		wantBody string
+	}{
+		{name: "instances", method: "GET", path: "/instances", wantCode: 200, wantBody: `"addr":"127.0.0.1:55000"`},
+		{name: "status", method: "GET", path: "/instances/WSJT-X/status", wantCode: 200, wantBody: `"dial":14074000`},
+		{name: "decodes", method: "GET", path: "/instances/WSJT-X/decodes?limit=1", wantCode: 200, wantBody: `"message":"CQ C1CC"`},
+		{name: "bad limit", method: "GET", path: "/instances/WSJT-X/decodes?limit=x", wantCode: 400, wantBody: `"field":"limit"`},
+		{name: "unknown instance", method: "GET", path: "/instances/JTDX/status", wantCode: 404, wantBody: `"error":"unknown instance"`},
+		{name: "halt tx", method: "POST", path: "/instances/WSJT-X/halt-tx", body: `{"auto":true}`, auth: true, wantCode: 204},
+		{name: "not authorized", method: "POST", path: "/instances/WSJT-X/halt-tx", body: `{"auto":true}`, wantCode: 403},
+		{name: "no address", method: "POST", path: "/instances/MSHV/halt-tx", body: `{"auto":true}`, auth: true, wantCode: 409},
+		{name: "validation", method: "POST", path: "/instances/WSJT-X/highlight", body: `{}`, auth: true, wantCode: 400, wantBody: `"field":"callsign"`},
+		{name: "wrong method", method: "GET", path: "/instances/WSJT-X/replay", wantCode: 405},
+		{name: "openapi", method: "GET", path: "/openapi.json", wantCode: 200, wantBody: `"openapi"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.auth {
+				req.Header.Set("Authorization", "Bearer secret")
+			}
+
+			rec := httptest.NewRecorder()
+			api.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("%s %s = %d, want %d: %s", tt.method, tt.path, rec.Code, tt.wantCode, rec.Body)
+			}
+
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("%s %s body = %s, want %s", tt.method, tt.path, rec.Body, tt.wantBody)
+			}
+		})
+	}
+
+	if len(w.sent) != 1 || string(w.sent[0]) != string(message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: true})) {
+		t.Errorf("sent %x", w.sent)
+	}
+
+	var decodes []message.DecodeResponse
+
+	rec := httptest.NewRecorder()
+	api.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/instances/WSJT-X/decodes", nil))
+
+	if err := json.NewDecoder(rec.Body).Decode(&decodes); err != nil || len(decodes) != 2 {
+		t.Errorf("recent decodes = %+v, %v, want the last 2", decodes, err)
+	}
+}
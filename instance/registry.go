@@ -0,0 +1,371 @@
+// Package instance tracks the WSJT-X instances heard by a udpserver and their liveness.
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultHeartbeatInterval is the interval WSJT-X sends heartbeats at.
+	DefaultHeartbeatInterval = 15 * time.Second
+	// DefaultMaxMissed is the number of heartbeats that can be missed before an instance is down.
+	DefaultMaxMissed = 3
+
+	eventsBuffer  = 64
+	answersBuffer = 64
+	writeTimeout  = time.Second
+)
+
+// EventType tells what happened to an instance.
+type EventType int
+
+const (
+	InstanceUp EventType = iota
+	InstanceDown
+)
+
+func (t EventType) String() string {
+	switch t {
+	case InstanceUp:
+		return "up"
+	case InstanceDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
+
+// Reasons of the InstanceDown events.
+const (
+	ReasonClose   = "close"
+	ReasonTimeout = "timeout"
+)
+
+// Event is emitted when an instance comes up or goes down.
+type Event struct {
+	Type     EventType
+	Instance Instance
+	Reason   string
+	Time     time.Time
+}
+
+// Instance is a WSJT-X instance as known by the Registry.
+type Instance struct {
+	ID              string       `json:"id"`
+	Version         string       `json:"version"`
+	Revision        string       `json:"revision"`
+	MaxSchemaNumber uint32       `json:"maxSchemaNumber"`
+	Addr            *net.UDPAddr `json:"-"`
+	// Status is the latest status received, nil until the first one.
+	Status        *message.StatusResponse `json:"status"`
+	Up            bool                    `json:"up"`
+	FirstSeen     time.Time               `json:"firstSeen"`
+	LastSeen      time.Time               `json:"lastSeen"`
+	LastHeartbeat time.Time               `json:"lastHeartbeat"`
+}
+
+// MarshalJSON encodes the address of the instance as a string.
+func (i Instance) MarshalJSON() ([]byte, error) {
+	type plain Instance
+
+	addr := ""
+	if i.Addr != nil {
+		addr = i.Addr.String()
+	}
+
+	return json.Marshal(struct {
+		plain
+		Addr string `json:"addr"`
+	}{plain(i), addr})
+}
+
+// Writer sends datagrams to an address. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// Registry records the instances from their heartbeats, status and close messages,
+// emits InstanceUp/InstanceDown events and answers heartbeats.
+type Registry struct {
+	w         Writer
+	log       logger
+	heartbeat message.HeartbeatMessage
+	interval  time.Duration
+	maxMissed int
+	now       func() time.Time
+	events    chan Event
+	// answers are the heartbeat answers written by Run, so that a rate
+	// limited write never blocks the dispatch of the router.
+	answers   chan reply
+	mu        sync.RWMutex
+	instances map[string]*Instance
+}
+
+// reply is a heartbeat answer to an instance.
+type reply struct {
+	addr *net.UDPAddr
+	id   string
+}
+
+// Option configures a Registry.
+type Option func(*Registry)
+
+// WithHeartbeatInterval sets the interval instances are expected to send heartbeats at.
+func WithHeartbeatInterval(d time.Duration) Option {
+	return func(r *Registry) {
+		if d > 0 {
+			r.interval = d
+		}
+	}
+}
+
+// WithMaxMissed sets the number of heartbeats that can be missed before an instance is down.
+func WithMaxMissed(n int) Option {
+	return func(r *Registry) {
+		if n > 0 {
+			r.maxMissed = n
+		}
+	}
+}
+
+// WithHeartbeat sets the version sent when answering heartbeats. The ID is the one of the instance.
+func WithHeartbeat(h message.HeartbeatMessage) Option {
+	return func(r *Registry) {
+		r.heartbeat = h
+	}
+}
+
+// NewRegistry creates a Registry answering heartbeats with w, from Run. A nil w disables the answers.
+func NewRegistry(w Writer, logger logger, opts ...Option) *Registry {
+	r := &Registry{
+		w:   w,
+		log: logger,
+		heartbeat: message.HeartbeatMessage{
+			MaxSchemaNumber: 3,
+			Version:         "wsjtx-go",
+		},
+		interval:  DefaultHeartbeatInterval,
+		maxMissed: DefaultMaxMissed,
+		now:       time.Now,
+		events:    make(chan Event, eventsBuffer),
+		answers:   make(chan reply, answersBuffer),
+		instances: make(map[string]*Instance),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Register feeds the registry with the messages dispatched by router.
+func (r *Registry) Register(router *udpserver.Router) {
+	router.OnHeartbeat(func(m udpserver.Message, h message.HeartbeatResponse) {
+		r.Heartbeat(m.Addr, h)
+	})
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		r.Status(m.Addr, s)
+	})
+	router.OnClose(func(m udpserver.Message, c message.CloseResponse) {
+		r.Close(c)
+	})
+}
+
+// Events returns the InstanceUp and InstanceDown events.
+// The channel is buffered and events are dropped when it is full.
+func (r *Registry) Events() <-chan Event {
+	return r.events
+}
+
+// Heartbeat records a heartbeat and answers it with our own.
+func (r *Registry) Heartbeat(addr *net.UDPAddr, h message.HeartbeatResponse) {
+	now := r.now()
+
+	r.mu.Lock()
+	i := r.get(h.ID, addr, now)
+	i.Version = h.Version
+	i.Revision = h.Revision
+	i.MaxSchemaNumber = h.MaxSchemaNumber
+	i.LastHeartbeat = now
+
+	up := !i.Up
+	i.Up = true
+	snapshot := i.copy()
+	r.mu.Unlock()
+
+	if up {
+		r.emit(Event{Type: InstanceUp, Instance: snapshot, Time: now})
+	}
+
+	r.answer(addr, h.ID)
+}
+
+// Status records the latest status of an instance.
+func (r *Registry) Status(addr *net.UDPAddr, s message.StatusResponse) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i := r.get(s.ID, addr, r.now())
+	i.Status = &s
+}
+
+// Close marks an instance as down.
+func (r *Registry) Close(c message.CloseResponse) {
+	now := r.now()
+
+	r.mu.Lock()
+	i, ok := r.instances[c.ID]
+
+	if !ok || !i.Up {
+		r.mu.Unlock()
+
+		return
+	}
+
+	i.Up = false
+	i.LastSeen = now
+	snapshot := i.copy()
+	r.mu.Unlock()
+
+	r.emit(Event{Type: InstanceDown, Instance: snapshot, Reason: ReasonClose, Time: now})
+}
+
+// Run checks for missed heartbeats and writes the heartbeat answers until ctx is done.
+func (r *Registry) Run(ctx context.Context) error {
+	t := time.NewTicker(r.interval / 2)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			r.check()
+		case a := <-r.answers:
+			r.send(a)
+		}
+	}
+}
+
+// check marks as down the instances that missed too many heartbeats.
+func (r *Registry) check() {
+	now := r.now()
+	timeout := r.interval * time.Duration(r.maxMissed)
+
+	var down []Instance
+
+	r.mu.Lock()
+	for _, i := range r.instances {
+		if i.Up && now.Sub(i.LastHeartbeat) > timeout {
+			i.Up = false
+			down = append(down, i.copy())
+		}
+	}
+	r.mu.Unlock()
+
+	for _, i := range down {
+		r.emit(Event{Type: InstanceDown, Instance: i, Reason: ReasonTimeout, Time: now})
+	}
+}
+
+// Get returns the instance with the given ID.
+func (r *Registry) Get(id string) (Instance, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.instances[id]
+	if !ok {
+		return Instance{}, false
+	}
+
+	return i.copy(), true
+}
+
+// List returns the known instances sorted by ID.
+func (r *Registry) List() []Instance {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	l := make([]Instance, 0, len(r.instances))
+	for _, i := range r.instances {
+		l = append(l, i.copy())
+	}
+
+	sort.Slice(l, func(a, b int) bool {
+		return l[a].ID < l[b].ID
+	})
+
+	return l
+}
+
+// get returns the instance with the given ID, creating it if needed. r.mu must be held.
+func (r *Registry) get(id string, addr *net.UDPAddr, now time.Time) *Instance {
+	i, ok := r.instances[id]
+	if !ok {
+		i = &Instance{ID: id, FirstSeen: now}
+		r.instances[id] = i
+	}
+
+	if addr != nil {
+		i.Addr = addr
+	}
+
+	i.LastSeen = now
+
+	return i
+}
+
+func (r *Registry) answer(addr *net.UDPAddr, id string) {
+	if r.w == nil {
+		return
+	}
+
+	select {
+	case r.answers <- reply{addr: addr, id: id}:
+	default:
+		r.log.Println("registry: heartbeat answer dropped:", id)
+	}
+}
+
+func (r *Registry) send(a reply) {
+	h := r.heartbeat
+	h.ID = a.id
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	if err := r.w.WriteTo(ctx, message.EncodeHearthBeat(h), a.addr); err != nil {
+		r.log.Println("registry: cannot answer heartbeat of", a.id+":", err)
+	}
+}
+
+func (r *Registry) emit(e Event) {
+	select {
+	case r.events <- e:
+	default:
+		r.log.Println("registry: event dropped:", e.Type, e.Instance.ID)
+	}
+}
+
+func (i *Instance) copy() Instance {
+	c := *i
+	if i.Status != nil {
+		s := *i.Status
+		c.Status = &s
+	}
+
+	return c
+}
@@ -0,0 +1,87 @@
+package instance
+
+import (
+	"context"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+type sent struct {
+	msg  []byte
+	addr *net.UDPAddr
+}
+
+type fakeWriter struct {
+	sent []sent
+}
+
+func (f *fakeWriter) WriteTo(_ context.Context, msg []byte, addr *net.UDPAddr) error {
+	f.sent = append(f.sent, sent{msg: msg, addr: addr})
+	return nil
+}
+
+func TestRegistry(t *testing.T) {
+	w := &fakeWriter{}
+	now := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+	r := NewRegistry(w, log.New(io.Discard, "", 0), WithMaxMissed(2))
+	r.now = func() time.Time { return now }
+
+	addr := &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 55000}
+	r.Status(addr, message.StatusResponse{ID: "WSJT-X", Dial: 14074000})
+	r.Heartbeat(addr, message.HeartbeatResponse{ID: "WSJT-X", MaxSchemaNumber: 3, Version: "2.5.2", Revision: "c19d62"})
+	r.Heartbeat(addr, message.HeartbeatResponse{ID: "WSJT-X", MaxSchemaNumber: 3, Version: "2.5.2", Revision: "c19d62"})
+
+	e := <-r.Events()
+	if e.Type != InstanceUp || e.Instance.Version != "2.5.2" || e.Instance.Status.Dial != 14074000 {
+		t.Errorf("Events() = %+v, want up with version and status", e)
+	}
+
+	// Run writes the answers.
+	for len(r.answers) > 0 {
+		r.send(<-r.answers)
+	}
+
+	if len(w.sent) != 2 || w.sent[0].addr != addr {
+		t.Fatalf("heartbeats sent = %d, want 2 to %s", len(w.sent), addr)
+	}
+
+	h, err := message.Parse(w.sent[0].msg)
+	if err != nil || h.Message.(message.HeartbeatResponse).ID != "WSJT-X" {
+		t.Errorf("heartbeat answer = %+v, %v", h, err)
+	}
+
+	now = now.Add(2 * DefaultHeartbeatInterval)
+	r.check()
+
+	if i, _ := r.Get("WSJT-X"); !i.Up {
+		t.Error("instance down before missing 2 heartbeats")
+	}
+
+	now = now.Add(time.Second)
+	r.check()
+
+	e = <-r.Events()
+	if e.Type != InstanceDown || e.Reason != ReasonTimeout {
+		t.Errorf("Events() = %+v, want down on timeout", e)
+	}
+
+	r.Heartbeat(addr, message.HeartbeatResponse{ID: "WSJT-X"})
+	r.Close(message.CloseResponse{ID: "WSJT-X"})
+
+	if e = <-r.Events(); e.Type != InstanceUp {
+		t.Errorf("Events() = %+v, want up", e)
+	}
+
+	if e = <-r.Events(); e.Type != InstanceDown || e.Reason != ReasonClose {
+		t.Errorf("Events() = %+v, want down on close", e)
+	}
+
+	if l := r.List(); len(l) != 1 || l[0].Up {
+		t.Errorf("List() = %+v", l)
+	}
+}
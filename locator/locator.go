@@ -0,0 +1,95 @@
+// Package locator converts Maidenhead grid locators to coordinates and distances.
+package locator
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// earthRadius is the mean radius of the Earth in km.
+const earthRadius = 6371.0
+
+// Point is a position in degrees, north and east positive.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Center returns the center of a 2, 4, 6 or 8 character locator like FN42 or JN45ab.
+func Center(grid string) (Point, error) {
+	g := strings.ToUpper(strings.TrimSpace(grid))
+	if len(g) < 2 || len(g) > 8 || len(g)%2 != 0 {
+		return Point{}, fmt.Errorf("locator: invalid grid %q", grid)
+	}
+
+	var (
+		lon, lat = -180.0, -90.0
+		w, h     = 360.0, 180.0
+	)
+
+	for i := 0; i < len(g); i += 2 {
+		var base byte
+
+		switch i {
+		case 0:
+			base, w, h = 'A', w/18, h/18
+		case 2, 6:
+			base, w, h = '0', w/10, h/10
+		case 4:
+			base, w, h = 'A', w/24, h/24
+		}
+
+		// Characters below base wrap around and are over the limit too.
+		x, y := g[i]-base, g[i+1]-base
+		if int(x) >= limit(i) || int(y) >= limit(i) {
+			return Point{}, fmt.Errorf("locator: invalid grid %q", grid)
+		}
+
+		lon += float64(x) * w
+		lat += float64(y) * h
+	}
+
+	return Point{Lat: lat + h/2, Lon: lon + w/2}, nil
+}
+
+// limit is the number of values of the pair of characters at i.
+func limit(i int) int {
+	switch i {
+	case 0:
+		return 18
+	case 4:
+		return 24
+	default:
+		return 10
+	}
+}
+
+// Distance returns the great circle distance in km between two points.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLon := lat2-lat1, radians(b.Lon-a.Lon)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// GridDistance returns the distance in km between the centers of two locators.
+func GridDistance(a, b string) (float64, error) {
+	pa, err := Center(a)
+	if err != nil {
+		return 0, err
+	}
+
+	pb, err := Center(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return Distance(pa, pb), nil
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,57 @@
+package locator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCenter(t *testing.T) {
+	tests := []struct {
+		grid    string
+		want    Point
+		wantErr bool
+	}{
+		{grid: "JN", want: Point{Lat: 45, Lon: 10}},
+		{grid: "FN42", want: Point{Lat: 42.5, Lon: -71}},
+		{grid: "jn45ab", want: Point{Lat: 45.0625, Lon: 8.0417}},
+		{grid: "JN45AB12", want: Point{Lat: 45.0521, Lon: 8.0125}},
+		{grid: "RR73", want: Point{Lat: 83.5, Lon: 175}},
+		{grid: "SA00", wantErr: true},
+		{grid: "FN4", wantErr: true},
+		{grid: "FNAA", wantErr: true},
+		{grid: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.grid, func(t *testing.T) {
+			got, err := Center(tt.grid)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Center() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if math.Abs(got.Lat-tt.want.Lat) > 0.001 || math.Abs(got.Lon-tt.want.Lon) > 0.001 {
+				t.Errorf("Center() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGridDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{a: "FN42", b: "FN42", want: 0},
+		{a: "FN42", b: "JN45", want: 6160},
+		{a: "JO01", b: "QF56", want: 16900},
+	}
+	for _, tt := range tests {
+		got, err := GridDistance(tt.a, tt.b)
+		if err != nil || math.Abs(got-tt.want) > tt.want*0.01+1 {
+			t.Errorf("GridDistance(%s, %s) = %.0f, %v, want about %.0f", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+
+	if _, err := GridDistance("FN42", "XX99"); err == nil {
+		t.Error("GridDistance() invalid grid: want error")
+	}
+}
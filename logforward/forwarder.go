@@ -0,0 +1,443 @@
+// Package logforward forwards the contacts logged by WSJT-X to the local
+// loggers, like Log4OM, N1MM Logger+ or DXKeeper, queueing them on disk
+// while a logger is down.
+package logforward
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/adif"
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultRetry is how long a target is waited for after a failure.
+	DefaultRetry = 30 * time.Second
+	// DefaultDedupe is how long a contact is forwarded once.
+	DefaultDedupe = 10 * time.Minute
+
+	queueProgram = "wsjtx-go logforward queue"
+)
+
+// mfskSubmodes are the modes WSJT-X logs as MODE MFSK with a SUBMODE.
+var mfskSubmodes = map[string]bool{"FT4": true, "FST4": true, "Q65": true, "JS8": true}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// queue is the contacts waiting to be sent to a target.
+type queue struct {
+	target  Target
+	path    string
+	records []adif.Record
+	retryAt time.Time
+}
+
+// Forwarder sends each contact logged by WSJT-X to every target, in order.
+// WSJT-X sends both a QSOLogged and a LoggedADIF for a contact: the first one
+// is forwarded and the other dropped.
+type Forwarder struct {
+	log    logger
+	dir    string
+	retry  time.Duration
+	dedupe time.Duration
+	now    func() time.Time
+	wake   chan struct{}
+	mu     sync.Mutex
+	queues []*queue
+	// seen has the time the contacts forwarded were received.
+	seen map[string]time.Time
+}
+
+// Option configures a Forwarder.
+type Option func(*Forwarder)
+
+// WithQueueDir keeps the queues in dir, one ADIF file per target, so the
+// contacts not sent survive a restart. The queues are only in memory by default.
+func WithQueueDir(dir string) Option {
+	return func(f *Forwarder) {
+		f.dir = dir
+	}
+}
+
+// WithRetry sets how long a target is waited for after a failure.
+func WithRetry(d time.Duration) Option {
+	return func(f *Forwarder) {
+		if d > 0 {
+			f.retry = d
+		}
+	}
+}
+
+// WithDedupe sets how long a contact is forwarded once.
+func WithDedupe(d time.Duration) Option {
+	return func(f *Forwarder) {
+		if d >= 0 {
+			f.dedupe = d
+		}
+	}
+}
+
+// NewForwarder returns a forwarder to targets, loading the contacts queued on disk.
+func NewForwarder(targets []Target, logger logger, opts ...Option) (*Forwarder, error) {
+	f := &Forwarder{
+		log:    logger,
+		retry:  DefaultRetry,
+		dedupe: DefaultDedupe,
+		now:    time.Now,
+		wake:   make(chan struct{}, 1),
+		seen:   make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	if f.dir != "" {
+		if err := os.MkdirAll(f.dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	names := make(map[string]bool)
+
+	for _, t := range targets {
+		q := &queue{target: t}
+
+		if f.dir != "" {
+			name := fileName(t.Name())
+			if names[name] {
+				return nil, fmt.Errorf("logforward: duplicate target %q", t.Name())
+			}
+
+			names[name] = true
+			q.path = filepath.Join(f.dir, name+".adi")
+
+			records, err := load(q.path)
+			if err != nil {
+				return nil, fmt.Errorf("logforward: %s: %w", q.path, err)
+			}
+
+			q.records = records
+		}
+
+		f.queues = append(f.queues, q)
+	}
+
+	return f, nil
+}
+
+// Register feeds the forwarder with the messages dispatched by router.
+func (f *Forwarder) Register(router *udpserver.Router) {
+	router.OnQSOLogged(func(m udpserver.Message, q message.QSOLoggedResponse) {
+		f.QSOLogged(q)
+	})
+	router.OnLoggedADIF(func(m udpserver.Message, l message.LoggedADIFResponse) {
+		f.LoggedADIF(l)
+	})
+}
+
+// QSOLogged queues the contact of a QSOLogged.
+func (f *Forwarder) QSOLogged(q message.QSOLoggedResponse) {
+	f.Forward(RecordFromQSOLogged(q))
+}
+
+// LoggedADIF queues the contacts of a LoggedADIF.
+func (f *Forwarder) LoggedADIF(l message.LoggedADIFResponse) {
+	records, err := adif.Parse(l.ADIF)
+	if err != nil {
+		f.log.Println("logforward: LoggedADIF:", err)
+
+		return
+	}
+
+	for _, r := range records {
+		f.Forward(r)
+	}
+}
+
+// Forward queues r for every target, unless the same contact was forwarded
+// in the dedupe window.
+func (f *Forwarder) Forward(r adif.Record) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := f.now()
+
+	for k, at := range f.seen {
+		if now.Sub(at) >= f.dedupe {
+			delete(f.seen, k)
+		}
+	}
+
+	k := contactKey(r)
+	if _, ok := f.seen[k]; ok {
+		return
+	}
+
+	f.seen[k] = now
+
+	for _, q := range f.queues {
+		q.records = append(q.records, r)
+		f.add(q, r)
+	}
+
+	select {
+	case f.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Pending returns the number of contacts waiting for the target named name.
+func (f *Forwarder) Pending(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, q := range f.queues {
+		if q.target.Name() == name {
+			return len(q.records)
+		}
+	}
+
+	return 0
+}
+
+// Run sends the queued contacts until ctx is done, as they arrive and every
+// retry interval while a target fails.
+func (f *Forwarder) Run(ctx context.Context) error {
+	ticker := time.NewTicker(f.retry)
+	defer ticker.Stop()
+
+	f.Flush(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-f.wake:
+			f.Flush(ctx)
+		case <-ticker.C:
+			f.Flush(ctx)
+		}
+	}
+}
+
+// Flush sends the queued contacts of the targets not waiting for a retry,
+// stopping at the first failure of each target.
+func (f *Forwarder) Flush(ctx context.Context) {
+	f.mu.Lock()
+	queues := append([]*queue(nil), f.queues...)
+	f.mu.Unlock()
+
+	for _, q := range queues {
+		f.flush(ctx, q)
+	}
+}
+
+// flush sends the contacts of q, rewriting its queue file once at the end
+// rather than after each contact: a contact sent before a crash is sent again.
+func (f *Forwarder) flush(ctx context.Context, q *queue) {
+	sent := false
+
+	defer func() {
+		if sent {
+			f.mu.Lock()
+			f.save(q)
+			f.mu.Unlock()
+		}
+	}()
+
+	for ctx.Err() == nil {
+		f.mu.Lock()
+		if len(q.records) == 0 || f.now().Before(q.retryAt) {
+			f.mu.Unlock()
+
+			return
+		}
+
+		r := q.records[0]
+		f.mu.Unlock()
+
+		if err := q.target.Send(ctx, r); err != nil {
+			f.log.Println("logforward:", q.target.Name()+":", err)
+
+			f.mu.Lock()
+			q.retryAt = f.now().Add(f.retry)
+			f.mu.Unlock()
+
+			return
+		}
+
+		f.mu.Lock()
+		q.records = q.records[1:]
+		q.retryAt = time.Time{}
+		f.mu.Unlock()
+
+		sent = true
+	}
+}
+
+// add appends r to the queue file of q, starting it with a header when it is new.
+func (f *Forwarder) add(q *queue, r adif.Record) {
+	if q.path == "" {
+		return
+	}
+
+	file, err := os.OpenFile(q.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+	if err != nil {
+		f.log.Println("logforward:", err)
+
+		return
+	}
+
+	info, err := file.Stat()
+	if err == nil && info.Size() == 0 {
+		err = adif.Write(file, queueProgram, []adif.Record{r})
+	} else if err == nil {
+		_, err = fmt.Fprintln(file, r.String())
+	}
+
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		f.log.Println("logforward:", err)
+	}
+}
+
+// save writes the queue file of q, removing it when the queue is empty.
+func (f *Forwarder) save(q *queue) {
+	if q.path == "" {
+		return
+	}
+
+	if len(q.records) == 0 {
+		if err := os.Remove(q.path); err != nil && !os.IsNotExist(err) {
+			f.log.Println("logforward:", err)
+		}
+
+		return
+	}
+
+	tmp := q.path + ".tmp"
+
+	file, err := os.Create(tmp)
+	if err != nil {
+		f.log.Println("logforward:", err)
+
+		return
+	}
+
+	err = adif.Write(file, queueProgram, q.records)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp, q.path)
+	}
+
+	if err != nil {
+		f.log.Println("logforward:", err)
+	}
+}
+
+func load(path string) ([]adif.Record, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return adif.Read(file)
+}
+
+// fileName turns a target name into a file name.
+func fileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// contactKey identifies a contact by callsign, band, mode and start minute,
+// the same for the QSOLogged and the LoggedADIF of a contact.
+func contactKey(r adif.Record) string {
+	return strings.ToUpper(r.Get("CALL")) + "|" + strings.ToLower(r.Band()) + "|" + strings.ToUpper(r.Mode()) + "|" +
+		r.Get("QSO_DATE") + prefix(r.Get("TIME_ON"), 4)
+}
+
+func prefix(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+
+	return s
+}
+
+// RecordFromQSOLogged returns the ADIF record of a QSOLogged, with the fields
+// WSJT-X writes in its own log.
+func RecordFromQSOLogged(q message.QSOLoggedResponse) adif.Record {
+	r := adif.Record{
+		"CALL":             q.DXCall,
+		"GRIDSQUARE":       q.DXGrid,
+		"MODE":             q.Mode,
+		"RST_SENT":         q.ReportSent,
+		"RST_RCVD":         q.ReportReceived,
+		"TX_PWR":           q.TXPower,
+		"COMMENT":          q.Comments,
+		"NAME":             q.Name,
+		"OPERATOR":         q.OperatorCall,
+		"STATION_CALLSIGN": q.MyCall,
+		"MY_GRIDSQUARE":    q.MyGrid,
+		"STX_STRING":       q.ExchangeSent,
+		"SRX_STRING":       q.ExchangeReceived,
+		"PROP_MODE":        q.ADIFPropagationMode,
+		"BAND":             band.FromFrequency(q.TXFrequencyHz),
+	}
+
+	if mfskSubmodes[q.Mode] {
+		r["MODE"], r["SUBMODE"] = "MFSK", q.Mode
+	}
+
+	if q.TXFrequencyHz > 0 {
+		r["FREQ"] = strconv.FormatFloat(float64(q.TXFrequencyHz)/1e6, 'f', 6, 64)
+	}
+
+	if !q.DateAndTimeOn.IsZero() {
+		on := q.DateAndTimeOn.UTC()
+		r["QSO_DATE"], r["TIME_ON"] = on.Format("20060102"), on.Format("150405")
+	}
+
+	if !q.DateAndTimeOff.IsZero() {
+		off := q.DateAndTimeOff.UTC()
+		r["QSO_DATE_OFF"], r["TIME_OFF"] = off.Format("20060102"), off.Format("150405")
+	}
+
+	for k, v := range r {
+		if v == "" {
+			delete(r, k)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,167 @@
+package logforward
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/adif"
+	"github.com/logocomune/wsjtx/message"
+)
+
+type fakeTarget struct {
+	name string
+	err  error
+	sent []adif.Record
+}
+
+func (t *fakeTarget) Name() string {
+	return t.name
+}
+
+func (t *fakeTarget) Send(ctx context.Context, r adif.Record) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	t.sent = append(t.sent, r)
+
+	return nil
+}
+
+var (
+	on  = time.Date(2022, 2, 4, 10, 45, 15, 0, time.UTC)
+	off = time.Date(2022, 2, 4, 10, 46, 30, 0, time.UTC)
+)
+
+func qsoLogged(call, mode string) message.QSOLoggedResponse {
+	return message.QSOLoggedResponse{
+		ID:             "WSJT-X",
+		DateAndTimeOn:  on,
+		DateAndTimeOff: off,
+		DXCall:         call,
+		DXGrid:         "EN37",
+		TXFrequencyHz:  14074000,
+		Mode:           mode,
+		ReportSent:     "-10",
+		ReportReceived: "-12",
+		MyCall:         "K1ABC",
+		MyGrid:         "FN42",
+	}
+}
+
+func TestRecordFromQSOLogged(t *testing.T) {
+	want := adif.Record{
+		"CALL": "W9XYZ", "GRIDSQUARE": "EN37", "MODE": "MFSK", "SUBMODE": "FT4", "RST_SENT": "-10", "RST_RCVD": "-12",
+		"STATION_CALLSIGN": "K1ABC", "MY_GRIDSQUARE": "FN42", "BAND": "20m", "FREQ": "14.074000",
+		"QSO_DATE": "20220204", "TIME_ON": "104515", "QSO_DATE_OFF": "20220204", "TIME_OFF": "104630",
+	}
+
+	if got := RecordFromQSOLogged(qsoLogged("W9XYZ", "FT4")); !reflect.DeepEqual(got, want) {
+		t.Errorf("RecordFromQSOLogged() =\n%v, want\n%v", got, want)
+	}
+}
+
+func TestForwarderDedupe(t *testing.T) {
+	target := &fakeTarget{name: "fake"}
+
+	f, err := NewForwarder([]Target{target}, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := on
+	f.now = func() time.Time { return now }
+
+	f.QSOLogged(qsoLogged("W9XYZ", "FT4"))
+	f.LoggedADIF(message.LoggedADIFResponse{ID: "WSJT-X", ADIF: "\n<adif_ver:5>3.1.0\n<programid:6>WSJT-X\n<EOH>\n" +
+		"<call:5>W9XYZ <gridsquare:4>EN37 <mode:4>MFSK <submode:3>FT4 <qso_date:8>20220204 <time_on:6>104515 " +
+		"<band:3>20m <freq:9>14.074000 <EOR>"})
+	f.QSOLogged(qsoLogged("W9XYZ", "FT8"))
+
+	if got := f.Pending("fake"); got != 2 {
+		t.Fatalf("Pending() = %d, want 2", got)
+	}
+
+	now = now.Add(DefaultDedupe)
+	f.QSOLogged(qsoLogged("W9XYZ", "FT8"))
+	f.Flush(context.Background())
+
+	if len(target.sent) != 3 || f.Pending("fake") != 0 {
+		t.Errorf("sent %d contacts, %d pending, want 3 and 0", len(target.sent), f.Pending("fake"))
+	}
+}
+
+func TestForwarderQueue(t *testing.T) {
+	dir := t.TempDir()
+	down := errors.New("connection refused")
+	target := &fakeTarget{name: "dxkeeper 127.0.0.1:52001", err: down}
+	other := &fakeTarget{name: "n1mm 127.0.0.1:12060"}
+
+	f, err := NewForwarder([]Target{target, other}, log.New(io.Discard, "", 0), WithQueueDir(dir), WithRetry(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := on
+	f.now = func() time.Time { return now }
+
+	f.QSOLogged(qsoLogged("W9XYZ", "FT8"))
+	f.QSOLogged(qsoLogged("G4ABC", "FT8"))
+	f.Flush(context.Background())
+
+	if len(other.sent) != 2 {
+		t.Errorf("other target sent %d contacts, want 2", len(other.sent))
+	}
+
+	// The contacts are appended to the queue file, after a single header.
+	path := filepath.Join(dir, "dxkeeper_127.0.0.1_52001.adi")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("queue file: %v", err)
+	}
+
+	if n := strings.Count(string(data), "<EOH>"); n != 1 {
+		t.Errorf("queue file has %d headers, want 1:\n%s", n, data)
+	}
+
+	// The queue survives a restart.
+	f, err = NewForwarder([]Target{target}, log.New(io.Discard, "", 0), WithQueueDir(dir), WithRetry(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.now = func() time.Time { return now }
+	f.Flush(context.Background())
+
+	if got := f.Pending(target.name); got != 2 {
+		t.Fatalf("Pending() = %d, want 2", got)
+	}
+
+	// The target is not tried again before the retry interval.
+	target.err = nil
+	now = now.Add(30 * time.Second)
+	f.Flush(context.Background())
+
+	if len(target.sent) != 0 {
+		t.Fatalf("sent %d contacts before the retry", len(target.sent))
+	}
+
+	now = now.Add(30 * time.Second)
+	f.Flush(context.Background())
+
+	if len(target.sent) != 2 || target.sent[0].Get("CALL") != "W9XYZ" || target.sent[1].Get("CALL") != "G4ABC" {
+		t.Errorf("sent %v, want W9XYZ then G4ABC", target.sent)
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("queue file not removed: %v", err)
+	}
+}
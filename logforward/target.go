@@ -0,0 +1,223 @@
+package logforward
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/adif"
+	"github.com/logocomune/wsjtx/dxcc"
+)
+
+const (
+	sendTimeout = 10 * time.Second
+
+	// DXKeeperAddr is the TCP address of DXKeeper with the default base port 52000.
+	DXKeeperAddr = "127.0.0.1:52001"
+)
+
+// Target is a logger the contacts are forwarded to.
+type Target interface {
+	// Name identifies the target in the logs and names its queue file.
+	Name() string
+	// Send delivers a contact, returning an error when it should be sent again.
+	Send(ctx context.Context, r adif.Record) error
+}
+
+// ADIFUDP sends each contact as an ADIF record in a UDP datagram, as read by
+// Log4OM, Logger32 or HRD Logbook on their ADIF UDP inputs.
+type ADIFUDP struct {
+	Addr string
+}
+
+func (t ADIFUDP) Name() string {
+	return "adif_udp " + t.Addr
+}
+
+func (t ADIFUDP) Send(ctx context.Context, r adif.Record) error {
+	return sendUDP(ctx, t.Addr, []byte(r.String()))
+}
+
+// N1MM sends each contact as the contactinfo XML datagram of N1MM Logger+,
+// read by N1MM itself and the programs following its broadcasts.
+type N1MM struct {
+	Addr string
+	// Table gives the country prefix and continent, dxcc.Default() when nil.
+	Table *dxcc.Table
+}
+
+func (t N1MM) Name() string {
+	return "n1mm " + t.Addr
+}
+
+func (t N1MM) Send(ctx context.Context, r adif.Record) error {
+	table := t.Table
+	if table == nil {
+		table = dxcc.Default()
+	}
+
+	b, err := xml.MarshalIndent(contactInfo(r, table), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return sendUDP(ctx, t.Addr, append([]byte(xml.Header), b...))
+}
+
+// DXKeeper sends each contact to the TCP port of DXKeeper with its log
+// directive, DXKeeperAddr by default.
+type DXKeeper struct {
+	Addr string
+}
+
+func (t DXKeeper) Name() string {
+	return "dxkeeper " + t.addr()
+}
+
+func (t DXKeeper) addr() string {
+	if t.Addr == "" {
+		return DXKeeperAddr
+	}
+
+	return t.Addr
+}
+
+func (t DXKeeper) Send(ctx context.Context, r adif.Record) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", t.addr())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+
+	_, err = conn.Write([]byte(dxKeeperLog(r)))
+
+	return err
+}
+
+// dxKeeperLog returns the DXKeeper directive logging r.
+func dxKeeperLog(r adif.Record) string {
+	record := r.String()
+	params := fmt.Sprintf("<ExternalLogADIF:%d>%s", len(record), record)
+
+	return fmt.Sprintf("<command:3>log<parameters:%d>%s", len(params), params)
+}
+
+// sendUDP sends a datagram. Nothing tells whether a logger is listening, so
+// only the local errors are returned.
+func sendUDP(ctx context.Context, addr string, b []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Write(b)
+
+	return err
+}
+
+// n1mmBands are the band names of N1MM, in MHz.
+var n1mmBands = map[string]string{
+	"160m": "1.8", "80m": "3.5", "60m": "5", "40m": "7", "30m": "10", "20m": "14", "17m": "18",
+	"15m": "21", "12m": "24", "10m": "28", "6m": "50", "4m": "70", "2m": "144", "1.25m": "222",
+	"70cm": "420", "33cm": "902", "23cm": "1240",
+}
+
+type n1mmContact struct {
+	XMLName       xml.Name `xml:"contactinfo"`
+	App           string   `xml:"app"`
+	ContestName   string   `xml:"contestname"`
+	ContestNr     int      `xml:"contestnr"`
+	Timestamp     string   `xml:"timestamp"`
+	MyCall        string   `xml:"mycall"`
+	Band          string   `xml:"band"`
+	RxFreq        int64    `xml:"rxfreq"`
+	TxFreq        int64    `xml:"txfreq"`
+	Operator      string   `xml:"operator"`
+	Mode          string   `xml:"mode"`
+	Call          string   `xml:"call"`
+	CountryPrefix string   `xml:"countryprefix"`
+	Continent     string   `xml:"continent"`
+	Snt           string   `xml:"snt"`
+	Rcv           string   `xml:"rcv"`
+	GridSquare    string   `xml:"gridsquare"`
+	Exchange1     string   `xml:"exchange1"`
+	Name          string   `xml:"name"`
+	Comment       string   `xml:"comment"`
+	Power         string   `xml:"power"`
+	RadioNr       int      `xml:"radionr"`
+	ID            string   `xml:"ID"`
+}
+
+// contactInfo returns the N1MM contact of r. The frequencies are in tens of Hz.
+func contactInfo(r adif.Record, table *dxcc.Table) n1mmContact {
+	c := n1mmContact{
+		App:         "WSJT-X",
+		ContestName: "DX",
+		ContestNr:   1,
+		MyCall:      r.Get("STATION_CALLSIGN"),
+		Operator:    r.Get("OPERATOR"),
+		Call:        r.Get("CALL"),
+		Snt:         r.Get("RST_SENT"),
+		Rcv:         r.Get("RST_RCVD"),
+		GridSquare:  r.Get("GRIDSQUARE"),
+		Exchange1:   r.Get("SRX_STRING"),
+		Name:        r.Get("NAME"),
+		Comment:     r.Get("COMMENT"),
+		Power:       r.Get("TX_PWR"),
+		RadioNr:     1,
+	}
+
+	if on, ok := timeOn(r); ok {
+		c.Timestamp = on.Format("2006-01-02 15:04:05")
+	}
+
+	if mhz, err := strconv.ParseFloat(r.Get("FREQ"), 64); err == nil {
+		c.RxFreq = int64(mhz*1e5 + 0.5)
+		c.TxFreq = c.RxFreq
+	}
+
+	c.Mode = r.Mode()
+	c.Band = n1mmBands[strings.ToLower(r.Band())]
+
+	if e, ok := table.Lookup(c.Call); ok {
+		c.CountryPrefix, c.Continent = e.Prefix, e.Continent
+	}
+
+	sum := sha1.Sum([]byte(r.String()))
+	c.ID = hex.EncodeToString(sum[:16])
+
+	return c
+}
+
+// timeOn returns the start of the contact, from QSO_DATE and TIME_ON as HHMM or HHMMSS.
+func timeOn(r adif.Record) (time.Time, bool) {
+	layout := "20060102150405"
+	if len(r.Get("TIME_ON")) == 4 {
+		layout = "200601021504"
+	}
+
+	t, err := time.Parse(layout, r.Get("QSO_DATE")+r.Get("TIME_ON"))
+
+	return t, err == nil
+}
@@ -0,0 +1,113 @@
+package logforward
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/adif"
+)
+
+var record = adif.Record{
+	"CALL": "W9XYZ", "GRIDSQUARE": "EN37", "MODE": "FT8", "RST_SENT": "-10", "RST_RCVD": "-12",
+	"STATION_CALLSIGN": "K1ABC", "BAND": "20m", "FREQ": "14.075500", "QSO_DATE": "20220204", "TIME_ON": "104515",
+}
+
+func receiveUDP(t *testing.T, send func(addr string) error) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := send(conn.LocalAddr().String()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	buf := make([]byte, 4096)
+
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(buf[:n])
+}
+
+func TestADIFUDP(t *testing.T) {
+	got := receiveUDP(t, func(addr string) error {
+		return ADIFUDP{Addr: addr}.Send(context.Background(), record)
+	})
+
+	if got != record.String() {
+		t.Errorf("datagram = %q, want %q", got, record.String())
+	}
+}
+
+func TestN1MM(t *testing.T) {
+	got := receiveUDP(t, func(addr string) error {
+		return N1MM{Addr: addr}.Send(context.Background(), record)
+	})
+
+	if !strings.HasPrefix(got, xml.Header) {
+		t.Errorf("datagram has no XML header: %q", got)
+	}
+
+	var c n1mmContact
+	if err := xml.Unmarshal([]byte(got), &c); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Call != "W9XYZ" || c.MyCall != "K1ABC" || c.Band != "14" || c.RxFreq != 1407550 || c.Mode != "FT8" ||
+		c.Timestamp != "2022-02-04 10:45:15" || c.Snt != "-10" || c.Rcv != "-12" || c.Continent != "NA" || len(c.ID) != 32 {
+		t.Errorf("contactinfo = %+v", c)
+	}
+}
+
+func TestDXKeeper(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		b, _ := io.ReadAll(conn)
+		received <- string(b)
+	}()
+
+	if err := (DXKeeper{Addr: l.Addr().String()}).Send(context.Background(), record); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	rec := record.String()
+	params := "<ExternalLogADIF:" + strconv.Itoa(len(rec)) + ">" + rec
+	want := "<command:3>log<parameters:" + strconv.Itoa(len(params)) + ">" + params
+
+	if got := <-received; got != want {
+		t.Errorf("directive =\n%q, want\n%q", got, want)
+	}
+
+	l.Close()
+
+	if err := (DXKeeper{Addr: l.Addr().String()}).Send(context.Background(), record); err == nil {
+		t.Error("Send() to a closed port succeeded")
+	}
+}
@@ -11,6 +11,7 @@ import (
 const (
 	boolSize         = 1
 	quint8Size       = 1
+	quint16Size      = 2
 	quint32Size      = 4
 	quint64Size      = 8
 	floatSize        = 8
@@ -23,11 +24,12 @@ type msgDecoder struct {
 	buf []byte
 	len int
 	pos int
+	now time.Time
 }
 
 func (m *msgDecoder) decodeBoolean() (bool, error) {
 	end := m.pos
-	if m.len < end {
+	if m.len < end+boolSize {
 		return false, ErrMsgTooShort
 	}
 
@@ -47,6 +49,18 @@ func (m *msgDecoder) decodeQUINT8() (uint8, error) {
 	return m.buf[end], nil
 }
 
+func (m *msgDecoder) decodeQUINT16() (uint16, error) {
+	end := m.pos + quint16Size
+	if m.len < end {
+		return 0, ErrMsgTooShort
+	}
+
+	u := binary.BigEndian.Uint16(m.buf[m.pos:end])
+	m.pos = end
+
+	return u, nil
+}
+
 func (m *msgDecoder) decodeQUINT32() (uint32, error) {
 	end := m.pos + quint32Size
 	if m.len < end {
@@ -149,12 +163,70 @@ func (m *msgDecoder) decodeQDateTime() (time.Time, error) {
 }
 
 func (m *msgDecoder) decodeQTime() (uint32, time.Time, error) {
-	now := time.Now().Truncate(aDay)
 	msFromMD, err := m.decodeQUINT32()
-
 	if err != nil {
 		return 0, time.Time{}, err
 	}
 
-	return msFromMD, time.Unix(now.Unix()+int64(msFromMD/1000), 0).UTC(), nil
+	return msFromMD, nearestTime(m.now, msFromMD), nil
+}
+
+// nearestTime returns the time ms after a midnight UTC, on the day before,
+// of or after now putting it nearest to now: a decode of 23:59:45 received
+// at 00:00:01 is of the previous day.
+func nearestTime(now time.Time, ms uint32) time.Time {
+	t := now.UTC().Truncate(aDay).Add(time.Duration(ms/1000) * time.Second)
+
+	for _, d := range []time.Duration{-aDay, aDay} {
+		if other := t.Add(d); absDuration(other.Sub(now)) < absDuration(t.Sub(now)) {
+			t = other
+		}
+	}
+
+	return t
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
+
+// decodeQColor reads a QColor as written by encodeQColor. Colors of another
+// spec than RGB keep their raw channels.
+func (m *msgDecoder) decodeQColor() (QColor, error) {
+	var q QColor
+
+	spec, err := m.decodeQUINT8()
+	if err != nil {
+		return q, err
+	}
+	if q.Alpha, err = m.decodeQUINT16(); err != nil {
+		return QColor{}, err
+	}
+
+	if q.Red, err = m.decodeQUINT16(); err != nil {
+		return QColor{}, err
+	}
+
+	if q.Green, err = m.decodeQUINT16(); err != nil {
+		return QColor{}, err
+	}
+
+	if q.Blue, err = m.decodeQUINT16(); err != nil {
+		return QColor{}, err
+	}
+
+	// Padding.
+	if _, err = m.decodeQUINT16(); err != nil {
+		return QColor{}, err
+	}
+
+	if spec == invalidFormat {
+		return QColor{}, nil
+	}
+
+	return q, nil
 }
@@ -7,6 +7,7 @@ import (
 )
 
 const (
+	invalidFormat    = 0
 	rgbFormat        = 1
 	AlphaTransparent = 0
 	AlphaOpaque      = uint16(0xffff)
@@ -85,6 +86,18 @@ func (m *msgEncoder) encodeBoolean(b bool) {
 }
 
 func (m *msgEncoder) encodeQColor(q QColor) {
+	if q == (QColor{}) {
+		// Qt streams an invalid color as an opaque black with the invalid spec.
+		m.encodeQUInt8(invalidFormat)
+		m.encodeQUInt16(AlphaOpaque)
+		m.encodeQUInt16(0)
+		m.encodeQUInt16(0)
+		m.encodeQUInt16(0)
+		m.encodeQUInt16(padding)
+
+		return
+	}
+
 	m.encodeQUInt8(rgbFormat)
 	m.encodeQUInt16(q.Alpha)
 	m.encodeQUInt16(q.Red)
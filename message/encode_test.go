@@ -364,3 +364,10 @@ func TestEncodeConfigure(t *testing.T) {
 		})
 	}
 }
+
+func TestRGB(t *testing.T) {
+	want := QColor{Alpha: AlphaOpaque, Red: 0xffff, Green: 0x8080, Blue: 0}
+	if got := RGB(0xff8000); got != want {
+		t.Errorf("RGB(0xff8000) = %+v, want %+v", got, want)
+	}
+}
@@ -175,6 +175,8 @@ type HighlightCallsignMessage struct {
 	HighlightLast   bool
 }
 
+// QColor is a 16 bits per channel RGB color. The zero QColor is sent as an
+// invalid color, which clears a highlight.
 type QColor struct {
 	Alpha uint16
 	Red   uint16
@@ -182,6 +184,15 @@ type QColor struct {
 	Blue  uint16
 }
 
+// RGB returns the opaque color #rrggbb, scaling each 8 bits channel to 16 bits.
+func RGB(rgb uint32) QColor {
+	channel := func(shift uint) uint16 {
+		return uint16(rgb>>shift&0xff) * 0x101
+	}
+
+	return QColor{Alpha: AlphaOpaque, Red: channel(16), Green: channel(8), Blue: channel(0)}
+}
+
 type SwitchConfigurationMessage struct {
 	ID                string
 	ConfigurationName string
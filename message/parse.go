@@ -18,12 +18,47 @@ const (
 
 // Parse messages send from WSJT-X on UDP
 func Parse(buf []byte) (Response, error) {
+	return ParseAt(buf, time.Now())
+}
+
+// IsCommand reports whether buf holds a message that only WSJT-X receives,
+// like HaltTx or Configure. Clear and Close travel both ways and are not commands.
+func IsCommand(buf []byte) bool {
+	mP := &msgDecoder{buf: buf, len: len(buf)}
+
+	if m, err := mP.decodeQUINT32(); err != nil || m != magicUint {
+		return false
+	}
+
+	if _, err := mP.decodeQUINT32(); err != nil {
+		return false
+	}
+
+	messageType, err := mP.decodeQUINT32()
+	if err != nil {
+		return false
+	}
+
+	switch messageType {
+	case replyType, replayType, haltTxType, freeTextType, locationType,
+		highlightCallsignType, switchConfigurationType, configureType:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseAt parses a message received at the given time. WSJT-X only sends the
+// milliseconds since midnight for decodes, so the full time of the decode is
+// rebuilt on the day putting it nearest to receivedAt: the previous day for a
+// decode of 23:59:45 received after midnight.
+func ParseAt(buf []byte, receivedAt time.Time) (Response, error) {
 	size := len(buf)
 	if size == 0 || len(buf) == 0 {
 		return Response{}, ErrMsgTooShort
 	}
 
-	mP := &msgDecoder{buf: buf[:size], len: size, pos: 0}
+	mP := &msgDecoder{buf: buf[:size], len: size, pos: 0, now: receivedAt}
 	magicElement, err := mP.decodeQUINT32()
 
 	if err != nil {
@@ -280,7 +315,7 @@ func (m *msgDecoder) parseDecode() (DecodeResponse, error) {
 		return msg, err
 	}
 
-	msg.FullTime = time.Unix(time.Now().Truncate(24*time.Hour).Add(time.Duration(msg.Time/1000)*time.Second).Unix(), 0).UTC()
+	msg.FullTime = nearestTime(m.now, msg.Time)
 	if msg.DeltaFrequencyHz, err = m.decodeQUINT32(); err != nil {
 		return msg, err
 	}
@@ -28,7 +28,8 @@ type pResult struct {
 }
 
 func TestParser_ParseMessage(t *testing.T) {
-	y, m, d := time.Now().Date()
+	at := time.Date(2022, 2, 4, 12, 0, 0, 0, time.UTC)
+	y, m, d := at.Date()
 	type args struct {
 		buf  []byte
 		size int
@@ -96,7 +97,7 @@ func TestParser_ParseMessage(t *testing.T) {
 					ID:               "WSJT-X",
 					New:              true,
 					Time:             35340000,
-					FullTime:         time.Now().Truncate(24 * time.Hour).Add(time.Duration(35340000/1000) * time.Second).UTC(),
+					FullTime:         at.Truncate(24 * time.Hour).Add(time.Duration(35340000/1000) * time.Second).UTC(),
 					SNR:              -15,
 					DeltaTime:        -0.10000000149011612,
 					DeltaFrequencyHz: 1409,
@@ -200,13 +201,13 @@ func TestParser_ParseMessage(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Parse(tt.args.buf)
+			got, err := ParseAt(tt.args.buf, at)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("ParseAt() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("Parse() got = %+v, want %+v", got, tt.want)
+				t.Errorf("ParseAt() got = %+v, want %+v", got, tt.want)
 			}
 		})
 	}
@@ -251,3 +252,128 @@ func Test_msgParser_parseStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestIsCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		buf  []byte
+		want bool
+	}{
+		{name: "halt tx", buf: EncodeHaltTX(HaltTXMessage{ID: "WSJT-X"}), want: true},
+		{name: "configure", buf: EncodeConfigure(ConfigurationMessage{ID: "WSJT-X"}), want: true},
+		{name: "heartbeat", buf: EncodeHearthBeat(HeartbeatMessage{ID: "WSJT-X"}), want: false},
+		{name: "close", buf: EncodeClose(CloseMessage{ID: "WSJT-X"}), want: false},
+		{name: "too short", buf: []byte{0xad, 0xbc}, want: false},
+		{name: "invalid magic", buf: make([]byte, 12), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCommand(tt.buf); got != tt.want {
+				t.Errorf("IsCommand() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeek(t *testing.T) {
+	h, err := Peek(EncodeFreeText(FreeTextMessage{ID: "WSJT-X", Text: "TNX 73"}))
+	if err != nil || h != (Header{Type: FreeTextType, ID: "WSJT-X"}) {
+		t.Errorf("Peek() = %+v, %v", h, err)
+	}
+
+	if _, err := Peek([]byte{0xad, 0xbc, 0xcb}); err != ErrMsgTooShort {
+		t.Errorf("Peek(short) error = %v", err)
+	}
+}
+
+func TestParseHighlightCallsign(t *testing.T) {
+	want := HighlightCallsignMessage{
+		ID:              "WSJT-X",
+		Callsign:        "K1ABC",
+		BackgroundColor: QColor{Alpha: AlphaOpaque, Red: 0xffff},
+		ForegroundColor: QColor{Alpha: AlphaOpaque, Red: 0xffff, Green: 0xffff, Blue: 0xffff},
+		HighlightLast:   true,
+	}
+
+	buf := EncodeHighlightCallsign(want)
+
+	got, err := ParseHighlightCallsign(buf)
+	if err != nil || got != want {
+		t.Errorf("ParseHighlightCallsign() = %+v, %v", got, err)
+	}
+
+	if _, err := ParseHighlightCallsign(buf[:len(buf)-1]); err != ErrMsgTooShort {
+		t.Errorf("ParseHighlightCallsign(truncated) error = %v", err)
+	}
+
+	// The zero QColor is sent as an invalid color, to clear the highlight.
+	cleared := HighlightCallsignMessage{ID: "WSJT-X", Callsign: "K1ABC"}
+	buf = EncodeHighlightCallsign(cleared)
+
+	if spec := buf[len(buf)-2*(1+5*quint16Size)-boolSize]; spec != invalidFormat {
+		t.Errorf("background spec = %d, want invalid", spec)
+	}
+
+	if got, err := ParseHighlightCallsign(buf); err != nil || got != cleared {
+		t.Errorf("ParseHighlightCallsign(clear) = %+v, %v", got, err)
+	}
+}
+
+func TestNearestTime(t *testing.T) {
+	tests := []struct {
+		name       string
+		ms         uint32
+		receivedAt time.Time
+		want       time.Time
+	}{
+		{
+			name:       "same day",
+			ms:         (10*3600 + 40*60) * 1000,
+			receivedAt: time.Date(2022, 2, 4, 10, 40, 13, 0, time.UTC),
+			want:       time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC),
+		},
+		{
+			name:       "decoded before midnight",
+			ms:         (23*3600 + 59*60 + 45) * 1000,
+			receivedAt: time.Date(2022, 2, 5, 0, 0, 1, 0, time.UTC),
+			want:       time.Date(2022, 2, 4, 23, 59, 45, 0, time.UTC),
+		},
+		{
+			name:       "clock behind",
+			ms:         2 * 1000,
+			receivedAt: time.Date(2022, 2, 4, 23, 59, 59, 0, time.UTC),
+			want:       time.Date(2022, 2, 5, 0, 0, 2, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nearestTime(tt.receivedAt, tt.ms); !got.Equal(tt.want) {
+				t.Errorf("nearestTime() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAtPreviousDay(t *testing.T) {
+	decode, _ := hex.DecodeString(testDecode)
+	wspr, _ := hex.DecodeString(testWSPRDecode)
+	receivedAt := time.Date(2022, 2, 5, 1, 0, 0, 0, time.UTC)
+
+	resp, err := ParseAt(wspr, receivedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := resp.Message.(WSPRDecodeResponse).FullTime, time.Date(2022, 2, 4, 17, 46, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("WSPR FullTime = %s, want %s", got, want)
+	}
+
+	resp, err = ParseAt(decode, receivedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := resp.Message.(DecodeResponse).FullTime, time.Date(2022, 2, 5, 9, 49, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("decode FullTime = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,98 @@
+package message
+
+// typeNames maps the message type numbers to the *Type names.
+var typeNames = map[uint32]string{
+	heartbeatType:           HeartbeatType,
+	statusType:              StatusType,
+	decodeType:              DecodeType,
+	clearType:               ClearType,
+	replyType:               ReplyType,
+	qsoLoggedType:           QSOLoggedType,
+	closeType:               CloseType,
+	replayType:              ReplayType,
+	haltTxType:              HaltTxType,
+	freeTextType:            FreeTextType,
+	wsprDecodeType:          WSPRDecodeType,
+	locationType:            LocationType,
+	loggedADIFType:          LoggedADIFType,
+	highlightCallsignType:   HighlightCallsignType,
+	switchConfigurationType: SwitchConfigurationType,
+	configureType:           ConfigureType,
+}
+
+// Header is the part shared by every message: its type and the instance ID.
+type Header struct {
+	Type string
+	ID   string
+}
+
+// Peek reads the header of a datagram in either direction without parsing the rest.
+func Peek(buf []byte) (Header, error) {
+	mP := &msgDecoder{buf: buf, len: len(buf)}
+
+	m, err := mP.decodeQUINT32()
+	if err != nil {
+		return Header{}, err
+	}
+
+	if m != magicUint {
+		return Header{}, ErrInvalidMagic
+	}
+
+	if _, err := mP.decodeQUINT32(); err != nil {
+		return Header{}, err
+	}
+
+	messageType, err := mP.decodeQUINT32()
+	if err != nil {
+		return Header{}, err
+	}
+
+	name, ok := typeNames[messageType]
+	if !ok {
+		return Header{}, ErrUnknownSchema
+	}
+
+	id, err := mP.decodeUTF8()
+	if err != nil {
+		return Header{}, err
+	}
+
+	return Header{Type: name, ID: id}, nil
+}
+
+// ParseHighlightCallsign parses a datagram built by EncodeHighlightCallsign.
+func ParseHighlightCallsign(buf []byte) (HighlightCallsignMessage, error) {
+	h, err := Peek(buf)
+	if err != nil {
+		return HighlightCallsignMessage{}, err
+	}
+
+	if h.Type != HighlightCallsignType {
+		return HighlightCallsignMessage{}, ErrUnknownSchema
+	}
+
+	// Skip magic, schema, type and the ID already read by Peek.
+	mP := &msgDecoder{buf: buf, len: len(buf), pos: 12}
+	msg := HighlightCallsignMessage{}
+
+	if msg.ID, err = mP.decodeUTF8(); err != nil {
+		return msg, err
+	}
+
+	if msg.Callsign, err = mP.decodeUTF8(); err != nil {
+		return msg, err
+	}
+
+	if msg.BackgroundColor, err = mP.decodeQColor(); err != nil {
+		return msg, err
+	}
+
+	if msg.ForegroundColor, err = mP.decodeQColor(); err != nil {
+		return msg, err
+	}
+
+	msg.HighlightLast, err = mP.decodeBoolean()
+
+	return msg, err
+}
@@ -11,6 +11,16 @@ const (
 	CloseType      = "CLOSE"
 	WSPRDecodeType = "WSPRDecode"
 	LoggedADIFType = "LoggedADIF"
+
+	// Types of the messages sent to WSJT-X, as returned by Peek.
+	ReplyType               = "REPLY"
+	ReplayType              = "REPLAY"
+	HaltTxType              = "HaltTx"
+	FreeTextType            = "FreeText"
+	LocationType            = "LOCATION"
+	HighlightCallsignType   = "HighlightCallsign"
+	SwitchConfigurationType = "SwitchConfiguration"
+	ConfigureType           = "CONFIGURE"
 )
 
 type Response struct {
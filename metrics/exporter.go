@@ -0,0 +1,337 @@
+// Package metrics exports the state of a udpserver and of the WSJT-X instances
+// it hears in the Prometheus text format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+	// idLabel is the label of the instance ID: Prometheus renames an
+	// "instance" label, which it sets to the scraped target.
+	idLabel = "wsjtx_id"
+)
+
+var (
+	// SNRBuckets are the upper bounds, in dB, of the decode SNR histogram.
+	SNRBuckets = []float64{-24, -20, -15, -10, -5, 0, 5, 10, 20}
+	// DeltaTimeBuckets are the upper bounds, in seconds, of the decode DeltaTime histogram.
+	DeltaTimeBuckets = []float64{-2, -1, -0.5, -0.2, -0.1, 0, 0.1, 0.2, 0.5, 1, 2}
+)
+
+// StatusSource is implemented by *udpserver.UDPServer.
+type StatusSource interface {
+	GetStatus() udpserver.Status
+}
+
+// Exporter collects the server counters and the radio state of each instance.
+// It implements http.Handler, to be mounted on /metrics.
+type Exporter struct {
+	source StatusSource
+	mu     sync.RWMutex
+	snr    map[decodeKey]*histogram
+	dt     map[decodeKey]*histogram
+	radios map[string]message.StatusResponse
+}
+
+type decodeKey struct {
+	instance string
+	band     string
+	mode     string
+}
+
+func NewExporter(source StatusSource) *Exporter {
+	return &Exporter{
+		source: source,
+		snr:    make(map[decodeKey]*histogram),
+		dt:     make(map[decodeKey]*histogram),
+		radios: make(map[string]message.StatusResponse),
+	}
+}
+
+// Register feeds the exporter with the status and decode messages dispatched by r.
+func (e *Exporter) Register(r *udpserver.Router) {
+	r.OnStatus(func(_ udpserver.Message, s message.StatusResponse) {
+		e.ObserveStatus(s)
+	})
+	r.OnDecode(func(_ udpserver.Message, d message.DecodeResponse) {
+		e.ObserveDecode(d)
+	})
+}
+
+func (e *Exporter) ObserveStatus(s message.StatusResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.radios[s.ID] = s
+}
+
+func (e *Exporter) ObserveDecode(d message.DecodeResponse) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	radio := e.radios[d.ID]
+	k := decodeKey{instance: d.ID, band: band.FromFrequency(radio.Dial), mode: radio.Mode}
+
+	if _, ok := e.snr[k]; !ok {
+		e.snr[k] = newHistogram(SNRBuckets)
+		e.dt[k] = newHistogram(DeltaTimeBuckets)
+	}
+
+	e.snr[k].observe(float64(d.SNR))
+	e.dt[k].observe(d.DeltaTime)
+}
+
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", contentType)
+
+	if err := e.Write(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Write writes all the metrics in the Prometheus text format.
+func (e *Exporter) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	p := &printer{w: bw}
+
+	if e.source != nil {
+		e.writeServer(p, e.source.GetStatus())
+	}
+
+	e.mu.RLock()
+	e.writeRadios(p)
+	e.writeHistograms(p, "wsjtx_decode_snr_db", "SNR of the decodes in dB.", e.snr)
+	e.writeHistograms(p, "wsjtx_decode_delta_time_seconds", "DeltaTime of the decodes in seconds.", e.dt)
+	e.mu.RUnlock()
+
+	return bw.Flush()
+}
+
+func (e *Exporter) writeServer(p *printer, st udpserver.Status) {
+	p.counter("wsjtx_rx_messages_total", "Datagrams received.", float64(st.RxMessages))
+	p.counter("wsjtx_tx_messages_total", "Datagrams sent.", float64(st.TxMessages))
+	p.counter("wsjtx_rx_bytes_total", "Bytes received.", float64(st.RxBytes))
+	p.counter("wsjtx_tx_bytes_total", "Bytes sent.", float64(st.TxBytes))
+	p.counter("wsjtx_dropped_total", "Datagrams dropped because the consumer was too slow.", float64(st.Dropped))
+	p.counter("wsjtx_truncated_total", "Datagrams longer than the read buffer.", float64(st.Truncated))
+	p.gauge("wsjtx_uptime_seconds", "Seconds since the server started.", st.Uptime.Seconds())
+
+	p.header("wsjtx_messages_total", "Parsed messages by type.", "counter")
+	for _, k := range sortedKeys(st.MessagesByType) {
+		p.sample("wsjtx_messages_total", labels{"type", k}, float64(st.MessagesByType[k]))
+	}
+
+	p.header("wsjtx_parse_errors_total", "Datagrams that cannot be parsed by category.", "counter")
+	for _, k := range sortedKeys(st.ParseErrors) {
+		p.sample("wsjtx_parse_errors_total", labels{"category", k}, float64(st.ParseErrors[k]))
+	}
+
+	p.header("wsjtx_rejected_total", "Datagrams rejected by the source and ID policies by reason.", "counter")
+	for _, k := range sortedKeys(st.Rejected) {
+		p.sample("wsjtx_rejected_total", labels{"reason", k}, float64(st.Rejected[k]))
+	}
+
+	decodes := make([]udpserver.BandMode, 0, len(st.Decodes))
+	for k := range st.Decodes {
+		decodes = append(decodes, k)
+	}
+
+	sort.Slice(decodes, func(i, j int) bool {
+		if decodes[i].Band != decodes[j].Band {
+			return decodes[i].Band < decodes[j].Band
+		}
+
+		return decodes[i].Mode < decodes[j].Mode
+	})
+
+	p.header("wsjtx_decodes_total", "Decodes by band and mode.", "counter")
+	for _, k := range decodes {
+		p.sample("wsjtx_decodes_total", labels{"band", k.Band, "mode", k.Mode}, float64(st.Decodes[k]))
+	}
+}
+
+func (e *Exporter) writeRadios(p *printer) {
+	ids := make([]string, 0, len(e.radios))
+	for id := range e.radios {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	gauges := []struct {
+		name  string
+		help  string
+		value func(message.StatusResponse) float64
+	}{
+		{"wsjtx_dial_frequency_hz", "Dial frequency in Hz.", func(s message.StatusResponse) float64 { return float64(s.Dial) }},
+		{"wsjtx_transmitting", "1 while transmitting.", func(s message.StatusResponse) float64 { return boolValue(s.Transmitting) }},
+		{"wsjtx_decoding", "1 while decoding.", func(s message.StatusResponse) float64 { return boolValue(s.Decoding) }},
+		{"wsjtx_tx_enabled", "1 while TX is enabled.", func(s message.StatusResponse) float64 { return boolValue(s.TXEnabled) }},
+	}
+
+	// The mode is a label of the info metric only: on the gauges, a mode
+	// change would start new series.
+	p.header("wsjtx_radio_info", "Mode of each instance, always 1.", "gauge")
+
+	for _, id := range ids {
+		p.sample("wsjtx_radio_info", labels{idLabel, id, "mode", e.radios[id].Mode}, 1)
+	}
+
+	for _, g := range gauges {
+		p.header(g.name, g.help, "gauge")
+
+		for _, id := range ids {
+			p.sample(g.name, labels{idLabel, id}, g.value(e.radios[id]))
+		}
+	}
+}
+
+func (e *Exporter) writeHistograms(p *printer, name, help string, hs map[decodeKey]*histogram) {
+	keys := make([]decodeKey, 0, len(hs))
+	for k := range hs {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.instance != b.instance {
+			return a.instance < b.instance
+		}
+
+		if a.band != b.band {
+			return a.band < b.band
+		}
+
+		return a.mode < b.mode
+	})
+
+	p.header(name, help, "histogram")
+
+	for _, k := range keys {
+		h := hs[k]
+		l := labels{idLabel, k.instance, "band", k.band, "mode", k.mode}
+
+		for i, le := range h.bounds {
+			p.sample(name+"_bucket", append(l, "le", formatFloat(le)), float64(h.counts[i]))
+		}
+
+		p.sample(name+"_bucket", append(l, "le", "+Inf"), float64(h.count))
+		p.sample(name+"_sum", l, h.sum)
+		p.sample(name+"_count", l, float64(h.count))
+	}
+}
+
+// histogram is a cumulative Prometheus histogram.
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{bounds: bounds, counts: make([]uint64, len(bounds))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, le := range h.bounds {
+		if v <= le {
+			h.counts[i]++
+		}
+	}
+
+	h.count++
+	h.sum += v
+}
+
+// labels are name and value pairs.
+type labels []string
+
+type printer struct {
+	w *bufio.Writer
+}
+
+func (p *printer) header(name, help, kind string) {
+	fmt.Fprintf(p.w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func (p *printer) sample(name string, l labels, v float64) {
+	p.w.WriteString(name)
+
+	if len(l) > 0 {
+		p.w.WriteByte('{')
+
+		for i := 0; i+1 < len(l); i += 2 {
+			if i > 0 {
+				p.w.WriteByte(',')
+			}
+
+			fmt.Fprintf(p.w, `%s="%s"`, l[i], escape(l[i+1]))
+		}
+
+		p.w.WriteByte('}')
+	}
+
+	p.w.WriteByte(' ')
+	p.w.WriteString(formatFloat(v))
+	p.w.WriteByte('\n')
+}
+
+func (p *printer) counter(name, help string, v float64) {
+	p.header(name, help, "counter")
+	p.sample(name, nil, v)
+}
+
+func (p *printer) gauge(name, help string, v float64) {
+	p.header(name, help, "gauge")
+	p.sample(name, nil, v)
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escape(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
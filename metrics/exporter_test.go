@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+type staticSource udpserver.Status
+
+func (s staticSource) GetStatus() udpserver.Status {
+	return udpserver.Status(s)
+}
+
+func TestExporter_ServeHTTP(t *testing.T) {
+	e := NewExporter(staticSource{
+		RxMessages:     3,
+		MessagesByType: map[string]int64{message.DecodeType: 2, message.StatusType: 1},
+		ParseErrors:    map[string]int64{udpserver.ParseErrorInvalidMagic: 1},
+		Decodes:        map[udpserver.BandMode]int64{{Band: "20m", Mode: "FT8"}: 2},
+	})
+
+	e.ObserveStatus(message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8", Decoding: true})
+	e.ObserveDecode(message.DecodeResponse{ID: "WSJT-X", SNR: -12, DeltaTime: 0.1})
+	e.ObserveDecode(message.DecodeResponse{ID: "WSJT-X", SNR: 3, DeltaTime: -0.3})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"wsjtx_rx_messages_total 3\n",
+		`wsjtx_messages_total{type="DECODE"} 2` + "\n",
+		`wsjtx_parse_errors_total{category="invalid_magic"} 1` + "\n",
+		`wsjtx_decodes_total{band="20m",mode="FT8"} 2` + "\n",
+		`wsjtx_radio_info{wsjtx_id="WSJT-X",mode="FT8"} 1` + "\n",
+		`wsjtx_dial_frequency_hz{wsjtx_id="WSJT-X"} 1.4074e+07` + "\n",
+		`wsjtx_decoding{wsjtx_id="WSJT-X"} 1` + "\n",
+		`wsjtx_transmitting{wsjtx_id="WSJT-X"} 0` + "\n",
+		`wsjtx_decode_snr_db_bucket{wsjtx_id="WSJT-X",band="20m",mode="FT8",le="-10"} 1` + "\n",
+		`wsjtx_decode_snr_db_bucket{wsjtx_id="WSJT-X",band="20m",mode="FT8",le="+Inf"} 2` + "\n",
+		`wsjtx_decode_snr_db_sum{wsjtx_id="WSJT-X",band="20m",mode="FT8"} -9` + "\n",
+		`wsjtx_decode_delta_time_seconds_bucket{wsjtx_id="WSJT-X",band="20m",mode="FT8",le="0"} 1` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("ServeHTTP() body does not contain %q", want)
+		}
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != contentType {
+		t.Errorf("Content-Type = %q, want %q", ct, contentType)
+	}
+}
@@ -0,0 +1,245 @@
+// Package mqttbridge publishes the messages of a udpserver to an MQTT broker
+// and sends the commands received on MQTT to WSJT-X.
+//
+// Messages are published as the JSON of udpserver.Message on
+// <prefix>/<instance>/<type>, like wsjtx/WSJT-X/decode; status and heartbeat
+// are retained. Commands are read from <prefix>/<instance>/cmd/<command>, with
+// the command names and JSON parameters of the command package, and their
+// result is published on <prefix>/<instance>/cmd/<command>/result.
+package mqttbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/command"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	DefaultPrefix = "wsjtx"
+
+	writeTimeout   = 5 * time.Second
+	commandsBuffer = 64
+)
+
+var (
+	ErrUnknownInstance = errors.New("mqttbridge: unknown instance")
+	// ErrNoAddress is returned for the instances whose address is unknown, as
+	// a nil address would send the command to the last instance heard.
+	ErrNoAddress = errors.New("mqttbridge: instance address unknown")
+)
+
+// topics are the topic names of the message types.
+var topics = map[string]string{
+	message.HeartbeatType:  "heartbeat",
+	message.StatusType:     "status",
+	message.DecodeType:     "decode",
+	message.ClearType:      "clear",
+	message.QSOLoggedType:  "qso_logged",
+	message.CloseType:      "close",
+	message.WSPRDecodeType: "wspr_decode",
+	message.LoggedADIFType: "logged_adif",
+}
+
+// retained are the message types published as retained messages.
+var retained = map[string]bool{
+	message.HeartbeatType: true,
+	message.StatusType:    true,
+}
+
+// Client is the part of an MQTT client used by the bridge. NewPahoClient adapts
+// a Paho client; MemoryBroker provides in-process clients.
+type Client interface {
+	Publish(topic string, qos byte, retained bool, payload []byte) error
+	Subscribe(filter string, qos byte, handler func(topic string, payload []byte)) error
+}
+
+// Writer sends datagrams to an address. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+// Instances lists the known instances. It is implemented by *instance.Registry.
+type Instances interface {
+	List() []instance.Instance
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// Bridge connects a udpserver to an MQTT broker.
+type Bridge struct {
+	c         Client
+	w         Writer
+	instances Instances
+	log       logger
+	prefix    string
+	qos       byte
+	// commands are the commands received on MQTT, sent by Run so that the
+	// callback of the MQTT client never blocks on a write.
+	commands chan commandMsg
+}
+
+type commandMsg struct {
+	topic   string
+	payload []byte
+}
+
+// Option configures a Bridge.
+type Option func(*Bridge)
+
+// WithPrefix sets the first level of the topics, DefaultPrefix by default.
+func WithPrefix(prefix string) Option {
+	return func(b *Bridge) {
+		b.prefix = strings.TrimSuffix(prefix, "/")
+	}
+}
+
+// WithQoS sets the quality of service of the publications and subscriptions.
+func WithQoS(qos byte) Option {
+	return func(b *Bridge) {
+		if qos <= 2 {
+			b.qos = qos
+		}
+	}
+}
+
+// NewBridge creates a bridge sending the commands with w, from Run. A nil w
+// disables the commands.
+func NewBridge(c Client, w Writer, instances Instances, logger logger, opts ...Option) *Bridge {
+	b := &Bridge{
+		c:         c,
+		w:         w,
+		instances: instances,
+		log:       logger,
+		prefix:    DefaultPrefix,
+		commands:  make(chan commandMsg, commandsBuffer),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Register publishes every message dispatched by router and subscribes to the commands.
+func (b *Bridge) Register(router *udpserver.Router) error {
+	router.HandleAll(func(m udpserver.Message) {
+		if err := b.Publish(m); err != nil {
+			b.log.Println("mqttbridge: publish:", err)
+		}
+	})
+
+	if b.w == nil {
+		return nil
+	}
+
+	return b.c.Subscribe(b.prefix+"/+/cmd/+", b.qos, b.handleCommand)
+}
+
+// Publish publishes m on the topic of its instance and type.
+func (b *Bridge) Publish(m udpserver.Message) error {
+	name, ok := topics[m.ResponseType]
+	if !ok {
+		return nil
+	}
+
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return b.c.Publish(b.prefix+"/"+TopicLevel(m.InstanceID())+"/"+name, b.qos, retained[m.ResponseType], payload)
+}
+
+type result struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// handleCommand queues a command for Run.
+func (b *Bridge) handleCommand(topic string, payload []byte) {
+	select {
+	case b.commands <- commandMsg{topic: topic, payload: payload}:
+	default:
+		b.log.Println("mqttbridge: command dropped:", topic)
+	}
+}
+
+// Run sends the commands received on MQTT and publishes their result until ctx is done.
+func (b *Bridge) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case c := <-b.commands:
+			b.run(ctx, c.topic, c.payload)
+		}
+	}
+}
+
+func (b *Bridge) run(ctx context.Context, topic string, payload []byte) {
+	levels := strings.Split(strings.TrimPrefix(topic, b.prefix+"/"), "/")
+	if len(levels) != 3 || levels[1] != "cmd" {
+		return
+	}
+
+	res := result{OK: true}
+	if err := b.command(ctx, levels[0], levels[2], payload); err != nil {
+		res = result{Error: err.Error()}
+	}
+
+	data, _ := json.Marshal(res)
+	if err := b.c.Publish(topic+"/result", b.qos, false, data); err != nil {
+		b.log.Println("mqttbridge: publish result:", err)
+	}
+}
+
+func (b *Bridge) command(ctx context.Context, level, name string, params []byte) error {
+	var target *instance.Instance
+
+	for _, i := range b.instances.List() {
+		if TopicLevel(i.ID) == level {
+			i := i
+			target = &i
+
+			if i.Addr != nil {
+				break
+			}
+		}
+	}
+
+	if target == nil {
+		return ErrUnknownInstance
+	}
+
+	if target.Addr == nil {
+		return ErrNoAddress
+	}
+
+	msg, err := command.Encode(name, target.ID, params)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	return b.w.WriteTo(ctx, msg, target.Addr)
+}
+
+var levelReplacer = strings.NewReplacer("/", "_", "+", "_", "#", "_")
+
+// TopicLevel returns id with the characters that are not allowed in a topic level replaced by _.
+func TopicLevel(id string) string {
+	return levelReplacer.Replace(id)
+}
@@ -0,0 +1,125 @@
+package mqttbridge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"testing"
+
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+type fakeWriter struct {
+	sent [][]byte
+}
+
+func (f *fakeWriter) WriteTo(_ context.Context, msg []byte, _ *net.UDPAddr) error {
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{filter: "wsjtx/+/cmd/+", topic: "wsjtx/WSJT-X/cmd/halt_tx", want: true},
+		{filter: "wsjtx/+/cmd/+", topic: "wsjtx/WSJT-X/cmd/halt_tx/result", want: false},
+		{filter: "wsjtx/#", topic: "wsjtx/WSJT-X/status", want: true},
+		{filter: "wsjtx/+/status", topic: "wsjtx/WSJT-X/decode", want: false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.filter, tt.topic); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.filter, tt.topic, got, tt.want)
+		}
+	}
+}
+
+func TestBridge(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	registry := instance.NewRegistry(nil, logger)
+	registry.Heartbeat(&net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 55000}, message.HeartbeatResponse{ID: "WSJT-X"})
+	registry.Status(nil, message.StatusResponse{ID: "MSHV"})
+
+	broker := NewMemoryBroker()
+	w := &fakeWriter{}
+	b := NewBridge(broker, w, registry, logger)
+
+	router := udpserver.NewRouter(logger)
+	defer router.Close()
+
+	if err := b.Register(router); err != nil {
+		t.Fatal(err)
+	}
+
+	var decodes []string
+	_ = broker.Subscribe("wsjtx/+/decode", 0, func(topic string, payload []byte) {
+		decodes = append(decodes, topic)
+	})
+
+	router.Dispatch(udpserver.Message{Response: message.Response{
+		ResponseType: message.StatusType,
+		Message:      message.StatusResponse{ID: "WSJT-X", Dial: 14074000},
+	}})
+	router.Dispatch(udpserver.Message{Response: message.Response{
+		ResponseType: message.DecodeType,
+		Message:      message.DecodeResponse{ID: "WSJT-X", Message: "CQ K1ABC FN42"},
+	}})
+
+	if len(decodes) != 1 || decodes[0] != "wsjtx/WSJT-X/decode" {
+		t.Errorf("decode topics = %v", decodes)
+	}
+
+	status, ok := broker.Retained("wsjtx/WSJT-X/status")
+	if !ok {
+		t.Fatal("status not retained")
+	}
+
+	var got struct {
+		Message message.StatusResponse `json:"message"`
+	}
+	if err := json.Unmarshal(status, &got); err != nil || got.Message.Dial != 14074000 {
+		t.Errorf("retained status = %s, %v", status, err)
+	}
+
+	resultCh := make(chan result, 3)
+	_ = broker.Subscribe("wsjtx/+/cmd/+/result", 0, func(topic string, payload []byte) {
+		var r result
+		_ = json.Unmarshal(payload, &r)
+		resultCh <- r
+	})
+
+	// The commands are only queued by the callback of the client.
+	_ = broker.Publish("wsjtx/WSJT-X/cmd/free_text", 0, false, []byte(`{"text":"TNX 73","send":true}`))
+	_ = broker.Publish("wsjtx/JTDX/cmd/halt_tx", 0, false, nil)
+	_ = broker.Publish("wsjtx/MSHV/cmd/halt_tx", 0, false, nil)
+
+	if len(resultCh) != 0 {
+		t.Fatalf("%d results before Run", len(resultCh))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		_ = b.Run(ctx)
+		close(done)
+	}()
+
+	results := []result{<-resultCh, <-resultCh, <-resultCh}
+	cancel()
+	<-done
+
+	if len(results) != 3 || !results[0].OK || results[1].Error != ErrUnknownInstance.Error() || results[2].Error != ErrNoAddress.Error() {
+		t.Errorf("results = %+v", results)
+	}
+
+	if want := message.EncodeFreeText(message.FreeTextMessage{ID: "WSJT-X", Text: "TNX 73", Send: true}); len(w.sent) != 1 || string(w.sent[0]) != string(want) {
+		t.Errorf("sent %x, want %x", w.sent, want)
+	}
+}
@@ -0,0 +1,92 @@
+package mqttbridge
+
+import (
+	"strings"
+	"sync"
+)
+
+// MemoryBroker is an in-process stand-in for an MQTT broker, delivering
+// publications synchronously to the matching subscriptions and keeping
+// retained messages.
+type MemoryBroker struct {
+	mu       sync.RWMutex
+	subs     []subscription
+	retained map[string][]byte
+}
+
+type subscription struct {
+	filter  string
+	handler func(topic string, payload []byte)
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{retained: make(map[string][]byte)}
+}
+
+func (m *MemoryBroker) Publish(topic string, _ byte, retained bool, payload []byte) error {
+	m.mu.Lock()
+	if retained {
+		m.retained[topic] = payload
+	}
+
+	subs := append([]subscription{}, m.subs...)
+	m.mu.Unlock()
+
+	for _, s := range subs {
+		if Match(s.filter, topic) {
+			s.handler(topic, payload)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe registers handler and delivers it the matching retained messages.
+func (m *MemoryBroker) Subscribe(filter string, _ byte, handler func(topic string, payload []byte)) error {
+	m.mu.Lock()
+	m.subs = append(m.subs, subscription{filter: filter, handler: handler})
+
+	var matched [][2]string
+
+	for topic, payload := range m.retained {
+		if Match(filter, topic) {
+			matched = append(matched, [2]string{topic, string(payload)})
+		}
+	}
+	m.mu.Unlock()
+
+	for _, r := range matched {
+		handler(r[0], []byte(r[1]))
+	}
+
+	return nil
+}
+
+// Retained returns the retained message of topic.
+func (m *MemoryBroker) Retained(topic string) ([]byte, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	p, ok := m.retained[topic]
+
+	return p, ok
+}
+
+// Match reports whether topic matches the MQTT filter, with its + and # wildcards.
+func Match(filter, topic string) bool {
+	f := strings.Split(filter, "/")
+	t := strings.Split(topic, "/")
+
+	for i, level := range f {
+		switch {
+		case level == "#":
+			return true
+		case i >= len(t):
+			return false
+		case level != "+" && level != t[i]:
+			return false
+		}
+	}
+
+	return len(f) == len(t)
+}
@@ -0,0 +1,30 @@
+package mqttbridge
+
+import (
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+type pahoClient struct {
+	c mqtt.Client
+}
+
+// NewPahoClient adapts a connected Paho client.
+func NewPahoClient(c mqtt.Client) Client {
+	return pahoClient{c: c}
+}
+
+func (p pahoClient) Publish(topic string, qos byte, retained bool, payload []byte) error {
+	t := p.c.Publish(topic, qos, retained, payload)
+	t.Wait()
+
+	return t.Error()
+}
+
+func (p pahoClient) Subscribe(filter string, qos byte, handler func(topic string, payload []byte)) error {
+	t := p.c.Subscribe(filter, qos, func(_ mqtt.Client, m mqtt.Message) {
+		handler(m.Topic(), m.Payload())
+	})
+	t.Wait()
+
+	return t.Error()
+}
@@ -0,0 +1,300 @@
+// Package msgtext parses the text of the FT8, FT4 and JT65-style structured
+// messages found in DecodeResponse.Message and StatusResponse.TXMessage.
+package msgtext
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Kind is the kind of a structured message.
+type Kind int
+
+const (
+	// FreeText is anything that is not a structured message.
+	FreeText Kind = iota
+	// CQ is "CQ [modifier] CALL [GRID]".
+	CQ
+	// Call is "TO FROM [GRID]", the first call to a station.
+	Call
+	// Report is "TO FROM -10".
+	Report
+	// RogerReport is "TO FROM R-10".
+	RogerReport
+	// RRR is "TO FROM RRR".
+	RRR
+	// RR73 is "TO FROM RR73".
+	RR73
+	// SeventyThree is "TO FROM 73".
+	SeventyThree
+)
+
+var kindNames = map[Kind]string{
+	FreeText:     "free_text",
+	CQ:           "cq",
+	Call:         "call",
+	Report:       "report",
+	RogerReport:  "roger_report",
+	RRR:          "rrr",
+	RR73:         "rr73",
+	SeventyThree: "73",
+}
+
+func (k Kind) String() string {
+	if n, ok := kindNames[k]; ok {
+		return n
+	}
+
+	return "unknown"
+}
+
+// Message is a parsed message.
+type Message struct {
+	Kind Kind
+	// To is the station called, empty for CQ and free text.
+	To string
+	// From is the station sending, empty for free text and for the first part
+	// of a Fox message whose sender is only in the second part.
+	From string
+	// Grid is the 4 character locator of Call and CQ, when sent.
+	Grid string
+	// Report is the signal report in dB of Report and RogerReport.
+	Report int
+	// Modifier is the directed CQ target, like DX, NA or POTA.
+	Modifier string
+	Text     string
+}
+
+// Directed reports whether m is a CQ to a region or an activity.
+func (m Message) Directed() bool {
+	return m.Kind == CQ && m.Modifier != ""
+}
+
+// Parse parses a single message. The Fox compound messages are parsed by ParseAll.
+func Parse(text string) Message {
+	fields := clean(text)
+	m := Message{Kind: FreeText, Text: strings.TrimSpace(text)}
+
+	if len(fields) < 2 {
+		return m
+	}
+
+	if fields[0] == "CQ" || fields[0] == "QRZ" {
+		return parseCQ(fields, m)
+	}
+
+	to, from := callsign(fields[0]), callsign(fields[1])
+	if !IsCallsign(to) || !IsCallsign(from) {
+		return m
+	}
+
+	switch len(fields) {
+	case 2:
+		m.Kind = Call
+	case 3:
+		if !parseExchange(fields[2], &m) {
+			return Message{Kind: FreeText, Text: m.Text}
+		}
+	default:
+		return m
+	}
+
+	m.To, m.From = to, from
+
+	return m
+}
+
+// ParseAll parses text, splitting the Fox compound messages like
+// "K1ABC RR73; W9XYZ <KH1/KH7Z> -08" in one message per station called.
+// The sender of the second part is also the sender of the first one.
+func ParseAll(text string) []Message {
+	parts := strings.Split(text, ";")
+	if len(parts) != 2 {
+		return []Message{Parse(text)}
+	}
+
+	second := Parse(parts[1])
+	if second.Kind == FreeText {
+		return []Message{Parse(text)}
+	}
+
+	// The first part has no sender: "K1ABC RR73".
+	fields := clean(parts[0])
+	if len(fields) != 2 || !IsCallsign(callsign(fields[0])) {
+		return []Message{Parse(text)}
+	}
+
+	first := Message{To: callsign(fields[0]), From: second.From, Text: strings.TrimSpace(parts[0])}
+	if !parseExchange(fields[1], &first) {
+		return []Message{Parse(text)}
+	}
+
+	return []Message{first, second}
+}
+
+func parseCQ(fields []string, m Message) Message {
+	rest := fields[1:]
+
+	// "CQ DX K1ABC FN42", "CQ POTA K1ABC", but not "CQ K1ABC FN42".
+	if len(rest) >= 2 && !IsCallsign(callsign(rest[0])) && isModifier(rest[0]) {
+		m.Modifier = rest[0]
+		rest = rest[1:]
+	}
+
+	if len(rest) == 0 || len(rest) > 2 || !IsCallsign(callsign(rest[0])) {
+		return Message{Kind: FreeText, Text: m.Text}
+	}
+
+	m.Kind = CQ
+	m.From = callsign(rest[0])
+
+	if len(rest) == 2 {
+		if !IsGrid(rest[1]) {
+			return Message{Kind: FreeText, Text: m.Text}
+		}
+
+		m.Grid = rest[1]
+	}
+
+	return m
+}
+
+// parseExchange reads the third word of "TO FROM word" into m.
+func parseExchange(word string, m *Message) bool {
+	switch {
+	case word == "RRR":
+		m.Kind = RRR
+	case word == "RR73":
+		m.Kind = RR73
+	case word == "73":
+		m.Kind = SeventyThree
+	case IsGrid(word):
+		m.Kind = Call
+		m.Grid = word
+	case strings.HasPrefix(word, "R") && isReport(word[1:]):
+		m.Kind = RogerReport
+		m.Report, _ = strconv.Atoi(word[1:])
+	case isReport(word):
+		m.Kind = Report
+		m.Report, _ = strconv.Atoi(word)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// clean splits text in upper case words, dropping the decoder's trailing
+// markers like "?" or "a1".
+func clean(text string) []string {
+	fields := strings.Fields(strings.ToUpper(text))
+
+	for len(fields) > 0 {
+		last := fields[len(fields)-1]
+		if last != "?" && !(len(last) == 2 && last[0] == 'A' && last[1] >= '0' && last[1] <= '9') {
+			break
+		}
+
+		fields = fields[:len(fields)-1]
+	}
+
+	return fields
+}
+
+// callsign removes the brackets of the hashed callsigns, like <KH1/KH7Z>.
+func callsign(s string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(s, "<"), ">")
+}
+
+// IsCallsign reports whether s looks like a callsign, with an optional
+// prefix or suffix like EA8/K1ABC or K1ABC/P.
+func IsCallsign(s string) bool {
+	if len(s) < 3 || len(s) > 13 || s == "RR73" {
+		return false
+	}
+
+	letters, digits := 0, 0
+
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			letters++
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '/':
+		default:
+			return false
+		}
+	}
+
+	return letters > 0 && digits > 0
+}
+
+// IsGrid reports whether s is a 4 character Maidenhead locator. RR73 is not.
+func IsGrid(s string) bool {
+	if len(s) != 4 || s == "RR73" {
+		return false
+	}
+
+	return s[0] >= 'A' && s[0] <= 'R' && s[1] >= 'A' && s[1] <= 'R' &&
+		s[2] >= '0' && s[2] <= '9' && s[3] >= '0' && s[3] <= '9'
+}
+
+// MatchCall reports whether call matches the glob pattern, ignoring case:
+// * matches any sequence of characters, / included, and ? any character, so
+// *K1ABC* matches EA8/K1ABC and K1ABC/P.
+func MatchCall(pattern, call string) bool {
+	pattern, call = strings.ToUpper(pattern), strings.ToUpper(call)
+
+	// star is the position of the last * in pattern and next the position in
+	// call it is tried again from when the rest does not match.
+	p, c, star, next := 0, 0, -1, 0
+
+	for c < len(call) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == call[c]):
+			p++
+			c++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, c
+			p++
+		case star >= 0:
+			next++
+			p, c = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+// isReport reports whether s is a report like -10 or +05.
+func isReport(s string) bool {
+	if len(s) != 3 || (s[0] != '-' && s[0] != '+') {
+		return false
+	}
+
+	_, err := strconv.Atoi(s[1:])
+
+	return err == nil
+}
+
+// isModifier reports whether s is a CQ modifier: letters only, or a 3 digit frequency.
+func isModifier(s string) bool {
+	if len(s) == 0 || len(s) > 4 {
+		return false
+	}
+
+	for _, r := range s {
+		if !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
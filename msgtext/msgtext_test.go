@@ -0,0 +1,89 @@
+package msgtext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want Message
+	}{
+		{text: "CQ K1ABC FN42", want: Message{Kind: CQ, From: "K1ABC", Grid: "FN42"}},
+		{text: "CQ DX K1ABC FN42", want: Message{Kind: CQ, From: "K1ABC", Grid: "FN42", Modifier: "DX"}},
+		{text: "CQ POTA EA8/K1ABC", want: Message{Kind: CQ, From: "EA8/K1ABC", Modifier: "POTA"}},
+		{text: "CQ K1ABC FN42 a1", want: Message{Kind: CQ, From: "K1ABC", Grid: "FN42"}},
+		{text: "W9XYZ K1ABC FN42", want: Message{Kind: Call, To: "W9XYZ", From: "K1ABC", Grid: "FN42"}},
+		{text: "W9XYZ <K1ABC/P>", want: Message{Kind: Call, To: "W9XYZ", From: "K1ABC/P"}},
+		{text: "K1ABC W9XYZ -15", want: Message{Kind: Report, To: "K1ABC", From: "W9XYZ", Report: -15}},
+		{text: "W9XYZ K1ABC R+03", want: Message{Kind: RogerReport, To: "W9XYZ", From: "K1ABC", Report: 3}},
+		{text: "K1ABC W9XYZ RRR", want: Message{Kind: RRR, To: "K1ABC", From: "W9XYZ"}},
+		{text: "K1ABC W9XYZ RR73", want: Message{Kind: RR73, To: "K1ABC", From: "W9XYZ"}},
+		{text: "W9XYZ K1ABC 73", want: Message{Kind: SeventyThree, To: "W9XYZ", From: "K1ABC"}},
+		{text: "TNX QSO 73 GL", want: Message{Kind: FreeText}},
+		{text: "K1ABC W9XYZ HELLO", want: Message{Kind: FreeText}},
+		{text: "CQ", want: Message{Kind: FreeText}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			tt.want.Text = tt.text
+
+			if got := Parse(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAllFox(t *testing.T) {
+	got := ParseAll("K1ABC RR73; W9XYZ <KH1/KH7Z> -08")
+	want := []Message{
+		{Kind: RR73, To: "K1ABC", From: "KH1/KH7Z", Text: "K1ABC RR73"},
+		{Kind: Report, To: "W9XYZ", From: "KH1/KH7Z", Report: -8, Text: "W9XYZ <KH1/KH7Z> -08"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseAll() = %+v, want %+v", got, want)
+	}
+
+	if got := ParseAll("CQ K1ABC FN42"); len(got) != 1 || got[0].Kind != CQ {
+		t.Errorf("ParseAll(CQ) = %+v", got)
+	}
+}
+
+func TestIsGrid(t *testing.T) {
+	for s, want := range map[string]bool{"FN42": true, "JN45": true, "RR73": false, "SS12": false, "FN4": false} {
+		if got := IsGrid(s); got != want {
+			t.Errorf("IsGrid(%s) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestMatchCall(t *testing.T) {
+	tests := []struct {
+		pattern string
+		call    string
+		want    bool
+	}{
+		{pattern: "K1*", call: "K1ABC", want: true},
+		{pattern: "k1*", call: "K1ABC", want: true},
+		{pattern: "*ABC", call: "K1ABC", want: true},
+		{pattern: "K?ABC", call: "K1ABC", want: true},
+		{pattern: "*", call: "", want: true},
+		{pattern: "", call: "K1ABC", want: false},
+		{pattern: "W9*", call: "K1ABC", want: false},
+		{pattern: "*K1ABC", call: "EA8/K1ABC", want: true},
+		{pattern: "K1ABC*", call: "K1ABC/P", want: true},
+		{pattern: "*/P", call: "K1ABC/P", want: true},
+		{pattern: "EA8/*", call: "EA8/K1ABC", want: true},
+		{pattern: "K1*C", call: "K1ABC/P", want: false},
+		{pattern: "*A*C", call: "K1ABAC", want: true},
+		{pattern: "[K]1ABC", call: "K1ABC", want: false},
+	}
+	for _, tt := range tests {
+		if got := MatchCall(tt.pattern, tt.call); got != tt.want {
+			t.Errorf("MatchCall(%q, %q) = %v, want %v", tt.pattern, tt.call, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Package pskreporter reports the decodes to PSK Reporter with its IPFIX
+// based UDP protocol, described at https://pskreporter.info/pskdev.html.
+package pskreporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+)
+
+const (
+	ipfixVersion = 10
+	headerSize   = 16
+
+	templateSetID        = 2
+	optionsTemplateSetID = 3
+	receiverTemplateID   = 0x9992
+	senderTemplateID     = 0x9993
+
+	// enterprise is the IANA enterprise number of PSK Reporter.
+	enterprise   = 30351
+	enterpriseID = 0x8000
+	variable     = 0xffff
+
+	// flowStartSeconds is an IANA information element.
+	flowStartSeconds = 150
+
+	// maxPacketSize keeps the datagrams under the usual MTU.
+	maxPacketSize = 1400
+)
+
+// PSK Reporter information elements.
+const (
+	senderCallsign    = 1
+	receiverCallsign  = 2
+	senderLocator     = 3
+	receiverLocator   = 4
+	frequency         = 5
+	sNR               = 6
+	decoderSoftware   = 8
+	mode              = 10
+	informationSource = 11
+)
+
+// automatic is the informationSource of the spots decoded by software.
+const automatic = 1
+
+// Receiver is the station reporting the spots.
+type Receiver struct {
+	Callsign string
+	Locator  string
+	Software string
+}
+
+// Spot is a station heard by the receiver.
+type Spot struct {
+	Callsign    string
+	Locator     string
+	FrequencyHz uint64
+	SNR         int
+	Mode        string
+	Time        time.Time
+}
+
+type field struct {
+	id         uint16
+	length     uint16
+	enterprise bool
+}
+
+var receiverFields = []field{
+	{id: receiverCallsign, length: variable, enterprise: true},
+	{id: receiverLocator, length: variable, enterprise: true},
+	{id: decoderSoftware, length: variable, enterprise: true},
+}
+
+var senderFields = []field{
+	{id: senderCallsign, length: variable, enterprise: true},
+	{id: frequency, length: 4, enterprise: true},
+	{id: sNR, length: 1, enterprise: true},
+	{id: mode, length: variable, enterprise: true},
+	{id: senderLocator, length: variable, enterprise: true},
+	{id: informationSource, length: 1, enterprise: true},
+	{id: flowStartSeconds, length: 4},
+}
+
+// packet builds an IPFIX message.
+type packet struct {
+	buf bytes.Buffer
+}
+
+func (p *packet) u8(v uint8) {
+	p.buf.WriteByte(v)
+}
+
+func (p *packet) u16(v uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	p.buf.Write(b[:])
+}
+
+func (p *packet) u32(v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	p.buf.Write(b[:])
+}
+
+// str writes a variable length string, truncated to 254 bytes.
+func (p *packet) str(s string) {
+	if len(s) > 254 {
+		s = s[:254]
+	}
+
+	p.u8(uint8(len(s)))
+	p.buf.WriteString(s)
+}
+
+// set writes a set with its header and padding.
+func (p *packet) set(id uint16, body []byte) {
+	pad := (4 - len(body)%4) % 4
+
+	p.u16(id)
+	p.u16(uint16(4 + len(body) + pad))
+	p.buf.Write(body)
+	p.buf.Write(make([]byte, pad))
+}
+
+func (p *packet) fields(fields []field) {
+	for _, f := range fields {
+		if f.enterprise {
+			p.u16(enterpriseID | f.id)
+			p.u16(f.length)
+			p.u32(enterprise)
+		} else {
+			p.u16(f.id)
+			p.u16(f.length)
+		}
+	}
+}
+
+func templates() []byte {
+	var p packet
+
+	var receiver packet
+	receiver.u16(receiverTemplateID)
+	receiver.u16(uint16(len(receiverFields)))
+	receiver.u16(0)
+	receiver.fields(receiverFields)
+	p.set(optionsTemplateSetID, receiver.buf.Bytes())
+
+	var sender packet
+	sender.u16(senderTemplateID)
+	sender.u16(uint16(len(senderFields)))
+	sender.fields(senderFields)
+	p.set(templateSetID, sender.buf.Bytes())
+
+	return p.buf.Bytes()
+}
+
+func receiverRecord(r Receiver) []byte {
+	var p packet
+	p.str(r.Callsign)
+	p.str(r.Locator)
+	p.str(r.Software)
+
+	return p.buf.Bytes()
+}
+
+func senderRecord(s Spot) []byte {
+	snr := s.SNR
+	if snr < -128 {
+		snr = -128
+	} else if snr > 127 {
+		snr = 127
+	}
+
+	var p packet
+	p.str(s.Callsign)
+	p.u32(uint32(s.FrequencyHz))
+	p.u8(uint8(int8(snr)))
+	p.str(s.Mode)
+	p.str(s.Locator)
+	p.u8(automatic)
+	p.u32(uint32(s.Time.Unix()))
+
+	return p.buf.Bytes()
+}
+
+// encoder splits the spots of a receiver in IPFIX messages.
+type encoder struct {
+	domain uint32
+	// sequence counts the data records sent, as IPFIX requires.
+	sequence uint32
+}
+
+// encode returns the messages reporting spots, each with the receiver record
+// and, when withTemplates, the templates.
+func (e *encoder) encode(r Receiver, spots []Spot, now time.Time, withTemplates bool) [][]byte {
+	var (
+		out     [][]byte
+		head    []byte
+		records []byte
+		count   uint32
+	)
+
+	if withTemplates {
+		head = append(head, templates()...)
+	}
+
+	var rp packet
+	rp.set(receiverTemplateID, receiverRecord(r))
+	head = append(head, rp.buf.Bytes()...)
+
+	flush := func() {
+		var p packet
+		p.buf.Write(head)
+		if len(records) > 0 {
+			p.set(senderTemplateID, records)
+		}
+
+		out = append(out, e.message(p.buf.Bytes(), now))
+		e.sequence += count + 1
+		records, count = nil, 0
+	}
+
+	for _, s := range spots {
+		rec := senderRecord(s)
+		if count > 0 && headerSize+len(head)+4+len(records)+len(rec)+3 > maxPacketSize {
+			flush()
+		}
+
+		records = append(records, rec...)
+		count++
+	}
+
+	if count > 0 || len(out) == 0 {
+		flush()
+	}
+
+	return out
+}
+
+func (e *encoder) message(body []byte, now time.Time) []byte {
+	var p packet
+	p.u16(ipfixVersion)
+	p.u16(uint16(headerSize + len(body)))
+	p.u32(uint32(now.Unix()))
+	p.u32(e.sequence)
+	p.u32(e.domain)
+	p.buf.Write(body)
+
+	return p.buf.Bytes()
+}
@@ -0,0 +1,153 @@
+package pskreporter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// parsed is an IPFIX message decoded with the templates of this package.
+type parsed struct {
+	exportTime uint32
+	sequence   uint32
+	domain     uint32
+	templates  bool
+	receivers  []Receiver
+	spots      []Spot
+}
+
+func parse(t *testing.T, b []byte) parsed {
+	t.Helper()
+
+	if len(b) < headerSize || binary.BigEndian.Uint16(b) != ipfixVersion || int(binary.BigEndian.Uint16(b[2:])) != len(b) {
+		t.Fatalf("invalid header % x", b[:headerSize])
+	}
+
+	p := parsed{
+		exportTime: binary.BigEndian.Uint32(b[4:]),
+		sequence:   binary.BigEndian.Uint32(b[8:]),
+		domain:     binary.BigEndian.Uint32(b[12:]),
+	}
+
+	for rest := b[headerSize:]; len(rest) > 0; {
+		id, length := binary.BigEndian.Uint16(rest), int(binary.BigEndian.Uint16(rest[2:]))
+		if length%4 != 0 || length > len(rest) {
+			t.Fatalf("set %x has length %d", id, length)
+		}
+
+		body := rest[4:length]
+		rest = rest[length:]
+
+		switch id {
+		case templateSetID, optionsTemplateSetID:
+			p.templates = true
+		case receiverTemplateID:
+			f := strings.Split(strings.TrimRight(readStrings(body, 3), "\x00"), "|")
+			p.receivers = append(p.receivers, Receiver{Callsign: f[0], Locator: f[1], Software: f[2]})
+		case senderTemplateID:
+			for len(body) > 3 {
+				var s Spot
+
+				var n int
+				s.Callsign, n = readString(body)
+				body = body[n:]
+				s.FrequencyHz = uint64(binary.BigEndian.Uint32(body))
+				s.SNR = int(int8(body[4]))
+				body = body[5:]
+				s.Mode, n = readString(body)
+				body = body[n:]
+				s.Locator, n = readString(body)
+				body = body[n:]
+
+				if body[0] != automatic {
+					t.Errorf("informationSource = %d", body[0])
+				}
+
+				s.Time = time.Unix(int64(binary.BigEndian.Uint32(body[1:])), 0).UTC()
+				body = body[5:]
+				p.spots = append(p.spots, s)
+			}
+		default:
+			t.Fatalf("unknown set %x", id)
+		}
+	}
+
+	return p
+}
+
+func readString(b []byte) (string, int) {
+	n := int(b[0])
+
+	return string(b[1 : 1+n]), 1 + n
+}
+
+func readStrings(b []byte, count int) string {
+	var l []string
+
+	for i := 0; i < count; i++ {
+		s, n := readString(b)
+		l = append(l, s)
+		b = b[n:]
+	}
+
+	return strings.Join(l, "|")
+}
+
+func TestTemplates(t *testing.T) {
+	want := "0003 0024 9992 0003 0000 8002 ffff 0000768f 8004 ffff 0000768f 8008 ffff 0000768f 0000 " +
+		"0002 003c 9993 0007 8001 ffff 0000768f 8005 0004 0000768f 8006 0001 0000768f 800a ffff 0000768f " +
+		"8003 ffff 0000768f 800b 0001 0000768f 0096 0004"
+
+	if got := fmt.Sprintf("%x", templates()); got != strings.ReplaceAll(want, " ", "") {
+		t.Errorf("templates() = %s", got)
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	e := encoder{domain: 42}
+	now := time.Date(2022, 2, 4, 10, 45, 0, 0, time.UTC)
+	r := Receiver{Callsign: "K1ABC", Locator: "FN42", Software: "test"}
+
+	var spots []Spot
+	for i := 0; i < 60; i++ {
+		spots = append(spots, Spot{Callsign: fmt.Sprintf("W%dXYZ", i), Locator: "EN37", FrequencyHz: 14075500, SNR: -i, Mode: "FT8", Time: now})
+	}
+
+	packets := e.encode(r, spots, now, true)
+	if len(packets) != 2 {
+		t.Fatalf("encode() = %d packets, want 2", len(packets))
+	}
+
+	var got []Spot
+
+	for i, b := range packets {
+		if len(b) > maxPacketSize {
+			t.Errorf("packet %d is %d bytes", i, len(b))
+		}
+
+		p := parse(t, b)
+		if !p.templates || p.domain != 42 || p.exportTime != uint32(now.Unix()) || len(p.receivers) != 1 || p.receivers[0] != r {
+			t.Errorf("packet %d = %+v", i, p)
+		}
+
+		if i == 1 && p.sequence != uint32(len(got)+1) {
+			t.Errorf("second packet sequence = %d, want %d", p.sequence, len(got)+1)
+		}
+
+		got = append(got, p.spots...)
+	}
+
+	if len(got) != len(spots) || got[59] != spots[59] {
+		t.Errorf("spots = %d, last %+v", len(got), got[len(got)-1])
+	}
+
+	if e.sequence != uint32(len(spots)+2) {
+		t.Errorf("sequence = %d, want %d", e.sequence, len(spots)+2)
+	}
+
+	if p := parse(t, e.encode(r, nil, now, false)[0]); p.templates || len(p.spots) != 0 || len(p.receivers) != 1 {
+		t.Errorf("receiver only packet = %+v", p)
+	}
+}
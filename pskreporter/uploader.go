@@ -0,0 +1,328 @@
+package pskreporter
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultAddr is the PSK Reporter collector; port 14739 of the same host
+	// is the test collector, whose spots are checked but not stored.
+	DefaultAddr = "report.pskreporter.info:4739"
+	// DefaultInterval is how often the spots are sent, as PSK Reporter asks.
+	DefaultInterval = 5 * time.Minute
+	// DefaultDedupe is how long a callsign is reported once per band.
+	DefaultDedupe = time.Hour
+	// DefaultSoftware is the decoderSoftware reported.
+	DefaultSoftware = "wsjtx-go"
+
+	// templateDatagrams is the number of first datagrams carrying the
+	// templates; they are then sent again every templateInterval.
+	templateDatagrams = 3
+	templateInterval  = time.Hour
+)
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type station struct {
+	receiver Receiver
+	dial     uint64
+	mode     string
+}
+
+type dedupeKey struct {
+	call string
+	band string
+}
+
+// Uploader collects the spots of the decodes and sends them to PSK Reporter
+// every interval, reporting each callsign once per band in the dedupe window.
+// The receiver callsign and locator are those of the Status of each instance.
+type Uploader struct {
+	addr     string
+	interval time.Duration
+	dedupe   time.Duration
+	software string
+	log      logger
+	now      func() time.Time
+	mu       sync.Mutex
+	stations map[string]*station
+	pending  map[Receiver][]Spot
+	reported map[dedupeKey]time.Time
+	enc      encoder
+	sent     int
+	lastTmpl time.Time
+}
+
+// Option configures an Uploader.
+type Option func(*Uploader)
+
+// WithAddr sets the UDP address the spots are sent to, DefaultAddr by default.
+func WithAddr(addr string) Option {
+	return func(u *Uploader) {
+		u.addr = addr
+	}
+}
+
+// WithInterval sets how often the spots are sent. PSK Reporter asks for no less than DefaultInterval.
+func WithInterval(d time.Duration) Option {
+	return func(u *Uploader) {
+		if d > 0 {
+			u.interval = d
+		}
+	}
+}
+
+// WithDedupe sets how long a callsign is reported once per band.
+func WithDedupe(d time.Duration) Option {
+	return func(u *Uploader) {
+		if d >= 0 {
+			u.dedupe = d
+		}
+	}
+}
+
+// WithSoftware sets the decoderSoftware reported, like "WSJT-X 2.5.4".
+func WithSoftware(name string) Option {
+	return func(u *Uploader) {
+		u.software = name
+	}
+}
+
+func NewUploader(logger logger, opts ...Option) *Uploader {
+	u := &Uploader{
+		addr:     DefaultAddr,
+		interval: DefaultInterval,
+		dedupe:   DefaultDedupe,
+		software: DefaultSoftware,
+		log:      logger,
+		now:      time.Now,
+		stations: make(map[string]*station),
+		pending:  make(map[Receiver][]Spot),
+		reported: make(map[dedupeKey]time.Time),
+	}
+
+	var domain [4]byte
+	if _, err := rand.Read(domain[:]); err == nil {
+		u.enc.domain = binary.BigEndian.Uint32(domain[:])
+	}
+
+	for _, opt := range opts {
+		opt(u)
+	}
+
+	return u
+}
+
+// Register feeds the uploader with the messages dispatched by router.
+func (u *Uploader) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		u.Status(s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		u.Decode(m.Received, d)
+	})
+	router.OnWSPRDecode(func(m udpserver.Message, d message.WSPRDecodeResponse) {
+		u.WSPRDecode(m.Received, d)
+	})
+}
+
+// Status records the receiver, dial frequency and mode of the instance.
+func (u *Uploader) Status(s message.StatusResponse) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.stations[s.ID] = &station{
+		receiver: Receiver{Callsign: s.DECall, Locator: s.DEGrid, Software: u.software},
+		dial:     s.Dial,
+		mode:     s.Mode,
+	}
+}
+
+// Decode queues the spots of a decode received at. Replays, decodes of
+// recordings and decodes of instances without a receiver callsign are not
+// reported.
+func (u *Uploader) Decode(at time.Time, d message.DecodeResponse) {
+	if !d.New || d.OffAir {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	st, ok := u.stations[d.ID]
+	if !ok || st.receiver.Callsign == "" {
+		return
+	}
+
+	if !d.FullTime.IsZero() {
+		at = d.FullTime
+	}
+
+	if at.IsZero() {
+		at = u.now()
+	}
+
+	for _, m := range msgtext.ParseAll(d.Message) {
+		if m.From == "" || m.From == st.receiver.Callsign {
+			continue
+		}
+
+		u.add(st.receiver, Spot{
+			Callsign:    m.From,
+			Locator:     m.Grid,
+			FrequencyHz: st.dial + uint64(d.DeltaFrequencyHz),
+			SNR:         int(d.SNR),
+			Mode:        st.mode,
+			Time:        at,
+		})
+	}
+}
+
+// WSPRDecode queues the WSPR spot of a decode received at.
+func (u *Uploader) WSPRDecode(at time.Time, d message.WSPRDecodeResponse) {
+	if !d.New || d.OffAir {
+		return
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	st, ok := u.stations[d.ID]
+	if !ok || st.receiver.Callsign == "" {
+		return
+	}
+
+	if !d.FullTime.IsZero() {
+		at = d.FullTime
+	}
+
+	if at.IsZero() {
+		at = u.now()
+	}
+
+	u.add(st.receiver, Spot{
+		Callsign:    strings.Trim(d.Callsign, "<>"),
+		Locator:     d.Grid,
+		FrequencyHz: d.FrequencyHz,
+		SNR:         int(d.SNR),
+		Mode:        "WSPR",
+		Time:        at,
+	})
+}
+
+func (u *Uploader) add(r Receiver, s Spot) {
+	k := dedupeKey{call: s.Callsign, band: band.FromFrequency(s.FrequencyHz)}
+	if last, ok := u.reported[k]; ok && s.Time.Sub(last) < u.dedupe {
+		return
+	}
+
+	u.reported[k] = s.Time
+	u.pending[r] = append(u.pending[r], s)
+}
+
+// Pending returns the number of spots waiting to be sent.
+func (u *Uploader) Pending() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	n := 0
+	for _, spots := range u.pending {
+		n += len(spots)
+	}
+
+	return n
+}
+
+// Run sends the spots every interval, and once more when ctx is done.
+func (u *Uploader) Run(ctx context.Context) error {
+	conn, err := net.Dial("udp", u.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := u.Flush(conn); err != nil {
+				u.log.Println("pskreporter:", err)
+			}
+
+			return ctx.Err()
+		case <-ticker.C:
+			if err := u.Flush(conn); err != nil {
+				u.log.Println("pskreporter:", err)
+			}
+		}
+	}
+}
+
+// Flush writes the pending spots on conn, one receiver at a time. A receiver
+// whose first datagram was written is done even if a later one fails, so
+// that the collector never gets its spots twice; its spots not written are
+// lost.
+func (u *Uploader) Flush(conn net.Conn) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := u.now()
+	u.forget(now)
+
+	receivers := make([]Receiver, 0, len(u.pending))
+	for r := range u.pending {
+		receivers = append(receivers, r)
+	}
+
+	sort.Slice(receivers, func(i, j int) bool {
+		return receivers[i].Callsign < receivers[j].Callsign
+	})
+
+	for _, r := range receivers {
+		tmpl := u.sent < templateDatagrams || now.Sub(u.lastTmpl) >= templateInterval
+
+		for i, p := range u.enc.encode(r, u.pending[r], now, tmpl) {
+			if _, err := conn.Write(p); err != nil {
+				if i > 0 {
+					delete(u.pending, r)
+				}
+
+				return err
+			}
+
+			u.sent++
+			if tmpl {
+				u.lastTmpl = now
+			}
+		}
+
+		delete(u.pending, r)
+	}
+
+	return nil
+}
+
+// forget drops the reported callsigns out of the dedupe window.
+func (u *Uploader) forget(now time.Time) {
+	for k, last := range u.reported {
+		if now.Sub(last) >= u.dedupe {
+			delete(u.reported, k)
+		}
+	}
+}
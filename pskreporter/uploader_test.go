@@ -0,0 +1,131 @@
+package pskreporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestUploader(t *testing.T) {
+	collector, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer collector.Close()
+
+	now := time.Date(2022, 2, 4, 10, 45, 0, 0, time.UTC)
+	u := NewUploader(log.New(io.Discard, "", 0), WithAddr(collector.LocalAddr().String()), WithInterval(time.Hour), WithSoftware("test"))
+	u.now = func() time.Time { return now }
+
+	decode := func(id, text string, isNew bool) {
+		u.Decode(now, message.DecodeResponse{ID: id, New: isNew, FullTime: now, SNR: -12, DeltaFrequencyHz: 1500, Message: text})
+	}
+
+	decode("WSJT-X", "CQ W9XYZ EN37", true)
+	u.Status(message.StatusResponse{ID: "WSJT-X", DECall: "K1ABC", DEGrid: "FN42", Dial: 14074000, Mode: "FT8"})
+	u.Status(message.StatusResponse{ID: "six", DECall: "K1ABC", DEGrid: "FN42", Dial: 50313000, Mode: "FT8"})
+
+	decode("WSJT-X", "CQ W9XYZ EN37", true)
+	decode("WSJT-X", "N0DEF W9XYZ R-05", true)
+	decode("WSJT-X", "CQ JA1XYZ PM95", false)
+	decode("WSJT-X", "W9XYZ K1ABC -10", true)
+	decode("six", "CQ W9XYZ EN37", true)
+	u.WSPRDecode(now, message.WSPRDecodeResponse{ID: "WSJT-X", New: true, Callsign: "G4ABC", Grid: "IO91", FrequencyHz: 14097050, SNR: -25})
+
+	if got := u.Pending(); got != 3 {
+		t.Fatalf("Pending() = %d, want 3", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+
+	go func() { done <- u.Run(ctx) }()
+	cancel()
+
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() error = %v", err)
+	}
+
+	buf := make([]byte, 2048)
+
+	collector.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	n, _, err := collector.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("ReadFrom() error = %v", err)
+	}
+
+	p := parse(t, buf[:n])
+	if !p.templates || len(p.receivers) != 1 || p.receivers[0] != (Receiver{Callsign: "K1ABC", Locator: "FN42", Software: "test"}) {
+		t.Errorf("packet = %+v", p)
+	}
+
+	want := []Spot{
+		{Callsign: "W9XYZ", Locator: "EN37", FrequencyHz: 14075500, SNR: -12, Mode: "FT8", Time: now},
+		{Callsign: "W9XYZ", Locator: "EN37", FrequencyHz: 50314500, SNR: -12, Mode: "FT8", Time: now},
+		{Callsign: "G4ABC", Locator: "IO91", FrequencyHz: 14097050, SNR: -25, Mode: "WSPR", Time: now},
+	}
+
+	if len(p.spots) != len(want) {
+		t.Fatalf("spots = %+v, want %+v", p.spots, want)
+	}
+
+	for i := range want {
+		if p.spots[i] != want[i] {
+			t.Errorf("spot %d = %+v, want %+v", i, p.spots[i], want[i])
+		}
+	}
+
+	if u.Pending() != 0 {
+		t.Errorf("Pending() after Run = %d", u.Pending())
+	}
+
+	now = now.Add(DefaultDedupe)
+	decode("WSJT-X", "CQ W9XYZ EN37", true)
+
+	if u.Pending() != 1 {
+		t.Errorf("Pending() after the dedupe window = %d, want 1", u.Pending())
+	}
+}
+
+// failingConn fails the writes after the first ok ones.
+type failingConn struct {
+	net.Conn
+	ok int
+}
+
+func (c *failingConn) Write(b []byte) (int, error) {
+	if c.ok == 0 {
+		return 0, errors.New("network unreachable")
+	}
+
+	c.ok--
+
+	return len(b), nil
+}
+
+func TestUploaderFlushPartial(t *testing.T) {
+	u := NewUploader(log.New(io.Discard, "", 0))
+	r := Receiver{Callsign: "K1ABC", Locator: "FN42"}
+	now := time.Date(2022, 2, 4, 10, 45, 0, 0, time.UTC)
+
+	// 60 spots are sent in two datagrams.
+	for i := 0; i < 60; i++ {
+		u.pending[r] = append(u.pending[r], Spot{Callsign: fmt.Sprintf("W%dXYZ", i), FrequencyHz: 14075500, Mode: "FT8", Time: now})
+	}
+
+	if err := u.Flush(&failingConn{}); err == nil || u.Pending() != 60 {
+		t.Fatalf("Flush() error = %v, Pending() = %d, want an error and the spots kept", err, u.Pending())
+	}
+
+	if err := u.Flush(&failingConn{ok: 1}); err == nil || u.Pending() != 0 {
+		t.Errorf("Flush() error = %v, Pending() = %d, want an error and the spots dropped", err, u.Pending())
+	}
+}
@@ -0,0 +1,510 @@
+// Package qso follows the contacts of each WSJT-X instance through their stages,
+// from the decodes, the transmitted messages and the logged QSOs.
+package qso
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultTimeoutPeriods is the number of T/R periods without progress after which a QSO is abandoned.
+	DefaultTimeoutPeriods = 8
+	// DefaultCompleted is the number of ended QSOs kept.
+	DefaultCompleted = 100
+
+	defaultTRPeriod = 15 * time.Second
+	eventsBuffer    = 64
+)
+
+// Stage is how far a QSO went.
+type Stage int
+
+const (
+	CQSeen Stage = iota
+	Called
+	ReportSent
+	ReportReceived
+	RogerSent
+	RogerReceived
+	Completed
+	Logged
+	Abandoned
+)
+
+var stageNames = map[Stage]string{
+	CQSeen:         "cq_seen",
+	Called:         "called",
+	ReportSent:     "report_sent",
+	ReportReceived: "report_received",
+	RogerSent:      "roger_sent",
+	RogerReceived:  "roger_received",
+	Completed:      "completed",
+	Logged:         "logged",
+	Abandoned:      "abandoned",
+}
+
+func (s Stage) String() string {
+	if n, ok := stageNames[s]; ok {
+		return n
+	}
+
+	return "unknown"
+}
+
+// Done reports whether the QSO is over.
+func (s Stage) Done() bool {
+	return s == Logged || s == Abandoned
+}
+
+// Transition is a stage reached at a time.
+type Transition struct {
+	Stage Stage
+	Time  time.Time
+}
+
+// QSO is a contact between the operator of an instance and a DX station.
+type QSO struct {
+	Instance string
+	MyCall   string
+	DXCall   string
+	DXGrid   string
+	Mode     string
+	Dial     uint64
+	Stage    Stage
+	// ReportSent and ReportReceived are in dB, like -10; empty until exchanged.
+	ReportSent     string
+	ReportReceived string
+	Started        time.Time
+	Updated        time.Time
+	History        []Transition
+}
+
+// Event is sent when a QSO reaches a new stage.
+type Event struct {
+	QSO  QSO
+	Time time.Time
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type key struct {
+	instance string
+	call     string
+}
+
+type instanceState struct {
+	myCall   string
+	mode     string
+	dial     uint64
+	trPeriod time.Duration
+}
+
+// Tracker follows the QSOs of every instance. Parallel QSOs, as in Fox/Hound
+// mode, are tracked separately.
+type Tracker struct {
+	log       logger
+	periods   int
+	keep      int
+	now       func() time.Time
+	events    chan Event
+	mu        sync.Mutex
+	instances map[string]*instanceState
+	active    map[key]*QSO
+	cqs       map[key]time.Time
+	completed []QSO
+}
+
+// Option configures a Tracker.
+type Option func(*Tracker)
+
+// WithTimeoutPeriods sets the number of T/R periods without progress after which a QSO is abandoned.
+func WithTimeoutPeriods(n int) Option {
+	return func(t *Tracker) {
+		if n > 0 {
+			t.periods = n
+		}
+	}
+}
+
+// WithCompleted sets the number of ended QSOs kept.
+func WithCompleted(n int) Option {
+	return func(t *Tracker) {
+		if n >= 0 {
+			t.keep = n
+		}
+	}
+}
+
+func NewTracker(logger logger, opts ...Option) *Tracker {
+	t := &Tracker{
+		log:       logger,
+		periods:   DefaultTimeoutPeriods,
+		keep:      DefaultCompleted,
+		now:       time.Now,
+		events:    make(chan Event, eventsBuffer),
+		instances: make(map[string]*instanceState),
+		active:    make(map[key]*QSO),
+		cqs:       make(map[key]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
+
+// Register feeds the tracker with the messages dispatched by router.
+func (t *Tracker) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		t.Status(m.Received, s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		t.Decode(m.Received, d)
+	})
+	router.OnQSOLogged(func(m udpserver.Message, q message.QSOLoggedResponse) {
+		t.Logged(m.Received, q)
+	})
+}
+
+// Events returns the stage changes. The channel is buffered and events are dropped when it is full.
+func (t *Tracker) Events() <-chan Event {
+	return t.events
+}
+
+// Status records the operator, mode and T/R period of the instance and, while
+// transmitting, the message sent. An RRR, RR73 or 73 only moves a QSO in
+// progress.
+func (t *Tracker) Status(at time.Time, s message.StatusResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.instance(s.ID)
+	st.myCall = s.DECall
+	st.mode = s.Mode
+	st.dial = s.Dial
+
+	if s.TRPeriod > 0 {
+		st.trPeriod = time.Duration(s.TRPeriod) * time.Second
+	}
+
+	t.expire(at)
+
+	if !s.Transmitting || st.myCall == "" {
+		return
+	}
+
+	for _, m := range msgtext.ParseAll(s.TXMessage) {
+		if m.To == "" || (m.From != "" && m.From != st.myCall) {
+			continue
+		}
+
+		switch m.Kind {
+		case msgtext.Call:
+			t.advance(s.ID, st, m.To, Called, at, nil)
+		case msgtext.Report:
+			t.advance(s.ID, st, m.To, ReportSent, at, func(q *QSO) { q.ReportSent = report(m.Report) })
+		case msgtext.RogerReport:
+			t.advance(s.ID, st, m.To, RogerSent, at, func(q *QSO) { q.ReportSent = report(m.Report) })
+		case msgtext.RRR, msgtext.RR73, msgtext.SeventyThree:
+			// The closing messages never start a QSO: WSJT-X keeps sending
+			// them after the QSO is logged.
+			q := t.active[key{s.ID, m.To}]
+			if q == nil {
+				continue
+			}
+
+			stage := RogerSent
+			if q.Stage >= RogerReceived {
+				stage = Completed
+			}
+
+			t.advance(s.ID, st, m.To, stage, at, nil)
+		}
+	}
+}
+
+// Decode records the CQs and the messages sent to the operator of the
+// instance. As in Status, an RRR, RR73 or 73 only moves a QSO in progress.
+func (t *Tracker) Decode(at time.Time, d message.DecodeResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.instance(d.ID)
+	t.expire(at)
+
+	for _, m := range msgtext.ParseAll(d.Message) {
+		if m.Kind == msgtext.CQ {
+			t.cqs[key{d.ID, m.From}] = at
+
+			continue
+		}
+
+		if st.myCall == "" || m.To != st.myCall || m.From == "" {
+			continue
+		}
+
+		grid := func(q *QSO) {
+			if m.Grid != "" {
+				q.DXGrid = m.Grid
+			}
+		}
+
+		switch m.Kind {
+		case msgtext.Call:
+			t.advance(d.ID, st, m.From, Called, at, grid)
+		case msgtext.Report:
+			t.advance(d.ID, st, m.From, ReportReceived, at, func(q *QSO) { q.ReportReceived = report(m.Report) })
+		case msgtext.RogerReport:
+			t.advance(d.ID, st, m.From, RogerReceived, at, func(q *QSO) { q.ReportReceived = report(m.Report) })
+		case msgtext.RRR, msgtext.RR73:
+			q := t.active[key{d.ID, m.From}]
+			if q == nil {
+				continue
+			}
+
+			stage := RogerReceived
+			if q.Stage >= RogerSent {
+				stage = Completed
+			}
+
+			t.advance(d.ID, st, m.From, stage, at, nil)
+		case msgtext.SeventyThree:
+			if t.active[key{d.ID, m.From}] == nil {
+				continue
+			}
+
+			t.advance(d.ID, st, m.From, Completed, at, nil)
+		}
+	}
+}
+
+// Logged ends the QSO with the logged station, creating it if it was not followed.
+func (t *Tracker) Logged(at time.Time, l message.QSOLoggedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st := t.instance(l.ID)
+	if l.MyCall != "" {
+		st.myCall = l.MyCall
+	}
+
+	t.advance(l.ID, st, l.DXCall, Logged, at, func(q *QSO) {
+		q.Mode = l.Mode
+		q.ReportSent = l.ReportSent
+		q.ReportReceived = l.ReportReceived
+
+		if l.DXGrid != "" {
+			q.DXGrid = l.DXGrid
+		}
+	})
+}
+
+// Run abandons the stale QSOs every second until ctx is done.
+func (t *Tracker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			t.mu.Lock()
+			t.expire(t.now())
+			t.mu.Unlock()
+		}
+	}
+}
+
+// Get returns the QSO in progress, or else the last completed one, with call.
+func (t *Tracker) Get(instance, call string) (QSO, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if q, ok := t.active[key{instance, call}]; ok {
+		return q.copy(), true
+	}
+
+	for i := len(t.completed) - 1; i >= 0; i-- {
+		if q := t.completed[i]; q.Instance == instance && q.DXCall == call {
+			return q.copy(), true
+		}
+	}
+
+	return QSO{}, false
+}
+
+// Active returns the QSOs in progress, oldest first.
+func (t *Tracker) Active() []QSO {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	l := make([]QSO, 0, len(t.active))
+	for _, q := range t.active {
+		l = append(l, q.copy())
+	}
+
+	sort.Slice(l, func(i, j int) bool {
+		return l[i].Started.Before(l[j].Started)
+	})
+
+	return l
+}
+
+// Completed returns the ended QSOs, logged, abandoned or completed but never logged, oldest first.
+func (t *Tracker) Completed() []QSO {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	l := make([]QSO, len(t.completed))
+	for i, q := range t.completed {
+		l[i] = q.copy()
+	}
+
+	return l
+}
+
+func (t *Tracker) instance(id string) *instanceState {
+	st, ok := t.instances[id]
+	if !ok {
+		st = &instanceState{trPeriod: defaultTRPeriod}
+		t.instances[id] = st
+	}
+
+	return st
+}
+
+// advance moves the QSO with call to stage, never backwards, applying update.
+func (t *Tracker) advance(instance string, st *instanceState, call string, stage Stage, at time.Time, update func(*QSO)) {
+	k := key{instance, call}
+
+	q, ok := t.active[k]
+	if !ok {
+		q = &QSO{
+			Instance: instance,
+			MyCall:   st.myCall,
+			DXCall:   call,
+			Mode:     st.mode,
+			Dial:     st.dial,
+			Started:  at,
+			Stage:    CQSeen,
+		}
+
+		if cq, seen := t.cqs[k]; seen {
+			q.Started = cq
+			q.History = append(q.History, Transition{Stage: CQSeen, Time: cq})
+			delete(t.cqs, k)
+		}
+
+		t.active[k] = q
+	}
+
+	if update != nil {
+		update(q)
+	}
+
+	q.Updated = at
+
+	if stage <= q.Stage && len(q.History) > 0 {
+		return
+	}
+
+	q.Stage = stage
+	q.History = append(q.History, Transition{Stage: stage, Time: at})
+	t.emit(Event{QSO: q.copy(), Time: at})
+
+	if stage.Done() {
+		t.finish(k, q)
+	}
+}
+
+// expire ends the QSOs without progress and forgets the old CQs.
+func (t *Tracker) expire(now time.Time) {
+	for k, q := range t.active {
+		if now.Sub(q.Updated) < t.timeout(k.instance) {
+			continue
+		}
+
+		// A completed QSO that is never logged is kept as completed.
+		if q.Stage == Completed {
+			t.finish(k, q)
+
+			continue
+		}
+
+		q.Stage = Abandoned
+		q.History = append(q.History, Transition{Stage: Abandoned, Time: now})
+		t.emit(Event{QSO: q.copy(), Time: now})
+		t.finish(k, q)
+	}
+
+	for k, at := range t.cqs {
+		if now.Sub(at) >= t.timeout(k.instance) {
+			delete(t.cqs, k)
+		}
+	}
+}
+
+func (t *Tracker) timeout(instance string) time.Duration {
+	period := defaultTRPeriod
+	if st, ok := t.instances[instance]; ok {
+		period = st.trPeriod
+	}
+
+	return time.Duration(t.periods) * period
+}
+
+func (t *Tracker) finish(k key, q *QSO) {
+	delete(t.active, k)
+
+	if t.keep == 0 {
+		return
+	}
+
+	t.completed = append(t.completed, *q)
+	if len(t.completed) > t.keep {
+		t.completed = append(t.completed[:0:0], t.completed[len(t.completed)-t.keep:]...)
+	}
+}
+
+func (t *Tracker) emit(e Event) {
+	select {
+	case t.events <- e:
+	default:
+		t.log.Println("qso tracker: event dropped:", e.QSO.DXCall, e.QSO.Stage)
+	}
+}
+
+func (q *QSO) copy() QSO {
+	c := *q
+	c.History = append([]Transition(nil), q.History...)
+
+	return c
+}
+
+func report(db int) string {
+	if db >= 0 {
+		return "+" + twoDigits(db)
+	}
+
+	return "-" + twoDigits(-db)
+}
+
+func twoDigits(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,204 @@
+package qso
+
+import (
+	"io"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func stages(q QSO) []Stage {
+	s := make([]Stage, 0, len(q.History))
+	for _, h := range q.History {
+		s = append(s, h.Stage)
+	}
+
+	return s
+}
+
+func drain(t *Tracker) []Event {
+	var events []Event
+
+	for {
+		select {
+		case e := <-t.Events():
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func status(tx string) message.StatusResponse {
+	return message.StatusResponse{ID: "WSJT-X", DECall: "K1ABC", Mode: "FT8", Dial: 14074000, TRPeriod: 15, Transmitting: tx != "", TXMessage: tx}
+}
+
+func decode(text string) message.DecodeResponse {
+	return message.DecodeResponse{ID: "WSJT-X", New: true, Message: text}
+}
+
+func TestTracker_Answering(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	tr.Status(at, status(""))
+	tr.Decode(at, decode("CQ W9XYZ EN37"))
+	tr.Status(at.Add(15*time.Second), status("W9XYZ K1ABC FN42"))
+	tr.Decode(at.Add(30*time.Second), decode("K1ABC W9XYZ -12"))
+	tr.Status(at.Add(45*time.Second), status("W9XYZ K1ABC R-08"))
+	tr.Decode(at.Add(60*time.Second), decode("K1ABC W9XYZ RR73"))
+	tr.Status(at.Add(75*time.Second), status("W9XYZ K1ABC 73"))
+
+	q, ok := tr.Get("WSJT-X", "W9XYZ")
+	if !ok {
+		t.Fatal("Get() not found")
+	}
+
+	want := []Stage{CQSeen, Called, ReportReceived, RogerSent, Completed}
+	if !reflect.DeepEqual(stages(q), want) {
+		t.Errorf("history = %v, want %v", stages(q), want)
+	}
+
+	if q.ReportSent != "-08" || q.ReportReceived != "-12" {
+		t.Errorf("reports = %q/%q, want -08/-12", q.ReportSent, q.ReportReceived)
+	}
+
+	if !q.Started.Equal(at) {
+		t.Errorf("Started = %s, want %s", q.Started, at)
+	}
+
+	if events := drain(tr); len(events) != 4 {
+		t.Errorf("events = %d, want 4", len(events))
+	}
+
+	tr.Logged(at.Add(80*time.Second), message.QSOLoggedResponse{ID: "WSJT-X", DXCall: "W9XYZ", DXGrid: "EN37", Mode: "FT8", ReportSent: "-08", ReportReceived: "-12"})
+
+	if got := tr.Active(); len(got) != 0 {
+		t.Errorf("Active() = %d QSOs, want 0", len(got))
+	}
+
+	completed := tr.Completed()
+	if len(completed) != 1 || completed[0].Stage != Logged || completed[0].DXGrid != "EN37" {
+		t.Errorf("Completed() = %+v, want the logged QSO", completed)
+	}
+
+	// WSJT-X still sends 73 after logging, and W9XYZ may repeat its RR73.
+	drain(tr)
+	tr.Status(at.Add(90*time.Second), status("W9XYZ K1ABC 73"))
+	tr.Decode(at.Add(90*time.Second), decode("K1ABC W9XYZ RR73"))
+
+	if got := tr.Active(); len(got) != 0 {
+		t.Errorf("Active() after the 73 = %+v, want none", got)
+	}
+
+	if events := drain(tr); len(events) != 0 {
+		t.Errorf("events after the 73 = %+v, want none", events)
+	}
+}
+
+func TestTracker_CQ(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	tr.Status(at, status("CQ K1ABC FN42"))
+	tr.Decode(at.Add(15*time.Second), decode("K1ABC W9XYZ EN37"))
+	tr.Status(at.Add(30*time.Second), status("W9XYZ K1ABC -05"))
+	tr.Decode(at.Add(45*time.Second), decode("K1ABC W9XYZ R-11"))
+	tr.Status(at.Add(60*time.Second), status("W9XYZ K1ABC RR73"))
+
+	q, _ := tr.Get("WSJT-X", "W9XYZ")
+
+	want := []Stage{Called, ReportSent, RogerReceived, Completed}
+	if !reflect.DeepEqual(stages(q), want) {
+		t.Errorf("history = %v, want %v", stages(q), want)
+	}
+
+	if q.DXGrid != "EN37" || q.ReportSent != "-05" || q.ReportReceived != "-11" {
+		t.Errorf("QSO = %+v", q)
+	}
+}
+
+func TestTracker_Fox(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	tr.Status(at, status(""))
+	tr.Decode(at, decode("K1ABC W9XYZ"))
+	tr.Decode(at, decode("K1ABC N0DEF"))
+	tr.Status(at.Add(15*time.Second), status("W9XYZ K1ABC -10"))
+	tr.Decode(at.Add(30*time.Second), decode("K1ABC W9XYZ R-07"))
+	tr.Status(at.Add(45*time.Second), status("W9XYZ RR73; N0DEF <K1ABC> -15"))
+
+	active := tr.Active()
+	if len(active) != 2 {
+		t.Fatalf("Active() = %d QSOs, want 2", len(active))
+	}
+
+	byCall := map[string]Stage{}
+	for _, q := range active {
+		byCall[q.DXCall] = q.Stage
+	}
+
+	if byCall["W9XYZ"] != Completed || byCall["N0DEF"] != ReportSent {
+		t.Errorf("stages = %v, want W9XYZ completed and N0DEF report_sent", byCall)
+	}
+}
+
+func TestTracker_Timeout(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0), WithTimeoutPeriods(4))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	s := status("")
+	s.TRPeriod = 7
+	tr.Status(at, s)
+	tr.Decode(at, decode("K1ABC W9XYZ EN37"))
+	tr.Decode(at, decode("K1ABC N0DEF EN10"))
+	tr.Decode(at.Add(21*time.Second), decode("K1ABC N0DEF 73"))
+	drain(tr)
+
+	tr.Status(at.Add(27*time.Second), s)
+
+	if got := tr.Active(); len(got) != 2 {
+		t.Fatalf("Active() before timeout = %d QSOs, want 2", len(got))
+	}
+
+	tr.Status(at.Add(28*time.Second), s)
+
+	events := drain(tr)
+	if len(events) != 1 || events[0].QSO.DXCall != "W9XYZ" || events[0].QSO.Stage != Abandoned {
+		t.Fatalf("events = %+v, want W9XYZ abandoned", events)
+	}
+
+	tr.Status(at.Add(49*time.Second), s)
+
+	completed := tr.Completed()
+	if len(completed) != 2 || completed[1].DXCall != "N0DEF" || completed[1].Stage != Completed {
+		t.Errorf("Completed() = %+v, want W9XYZ abandoned and N0DEF completed", completed)
+	}
+}
+
+func TestTracker_Ignored(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0), WithCompleted(1))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+
+	tr.Decode(at, decode("K1ABC W9XYZ EN37"))
+	tr.Status(at, status(""))
+
+	for _, text := range []string{"N0DEF W9XYZ -10", "TNX 73 GL", "CQ DX W9XYZ EN37"} {
+		tr.Decode(at, decode(text))
+	}
+
+	if got := tr.Active(); len(got) != 0 {
+		t.Errorf("Active() = %+v, want none", got)
+	}
+
+	tr.Logged(at, message.QSOLoggedResponse{ID: "WSJT-X", DXCall: "W9XYZ"})
+	tr.Logged(at, message.QSOLoggedResponse{ID: "WSJT-X", DXCall: "N0DEF"})
+
+	if got := tr.Completed(); len(got) != 1 || got[0].DXCall != "N0DEF" {
+		t.Errorf("Completed() = %+v, want only N0DEF", got)
+	}
+}
@@ -0,0 +1,324 @@
+// Package slot groups the decodes of each WSJT-X instance into the T/R
+// periods they were received in.
+package slot
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	batchesBuffer   = 64
+	defaultPeriod   = 15 * time.Second
+	ft4Period       = 7500 * time.Millisecond
+	replayIdle      = time.Second
+	checkInterval   = time.Second
+	millisecondsDay = 24 * 60 * 60 * 1000
+)
+
+// SNRStats summarizes the SNR of the decodes of a slot, in dB.
+type SNRStats struct {
+	Min    int32
+	Max    int32
+	Mean   float64
+	Median float64
+}
+
+// Batch is the decodes of an instance in one slot.
+type Batch struct {
+	Instance string
+	// Start is the beginning of the slot and Period its length.
+	Start  time.Time
+	Period time.Duration
+	Mode   string
+	Dial   uint64
+	Band   string
+	// Replay is set for the decodes WSJT-X sends again with New false, on a
+	// Replay request; they are never mixed with the new ones.
+	Replay        bool
+	Decodes       []message.DecodeResponse
+	LowConfidence int
+	SNR           SNRStats
+}
+
+// Count returns the number of decodes.
+func (b Batch) Count() int {
+	return len(b.Decodes)
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type slotKey struct {
+	start  time.Time
+	replay bool
+}
+
+type openSlot struct {
+	batch   *Batch
+	updated time.Time
+}
+
+type instanceState struct {
+	decoding bool
+	period   time.Duration
+	mode     string
+	dial     uint64
+	slots    map[slotKey]*openSlot
+}
+
+// Aggregator groups the decodes in slots. A slot is complete when its
+// decoding cycle ends: Status.Decoding goes from true to false once the slot
+// is over, as the early decoding passes of FT8 end before. Run completes the
+// slots whose cycle end was missed, two periods after their start, and the
+// replays one second after their last decode.
+type Aggregator struct {
+	log       logger
+	now       func() time.Time
+	batches   chan Batch
+	mu        sync.Mutex
+	instances map[string]*instanceState
+}
+
+func NewAggregator(logger logger) *Aggregator {
+	return &Aggregator{
+		log:       logger,
+		now:       time.Now,
+		batches:   make(chan Batch, batchesBuffer),
+		instances: make(map[string]*instanceState),
+	}
+}
+
+// Register feeds the aggregator with the messages dispatched by router.
+func (a *Aggregator) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		a.Status(m.Received, s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		a.Decode(m.Received, d)
+	})
+	router.OnClose(func(m udpserver.Message, c message.CloseResponse) {
+		a.Flush(c.ID)
+	})
+}
+
+// Batches returns the complete slots. The channel is buffered and batches are dropped when it is full.
+func (a *Aggregator) Batches() <-chan Batch {
+	return a.batches
+}
+
+// Status records the T/R period, mode and dial frequency of the instance and
+// completes its slots when decoding stops after their end.
+func (a *Aggregator) Status(at time.Time, s message.StatusResponse) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	st := a.instance(s.ID)
+	st.mode, st.dial = s.Mode, s.Dial
+	st.period = period(s)
+
+	stopped := st.decoding && !s.Decoding
+	st.decoding = s.Decoding
+
+	if !stopped {
+		return
+	}
+
+	for _, k := range sortedKeys(st.slots) {
+		if o := st.slots[k]; !k.replay && !at.Before(o.batch.Start.Add(o.batch.Period)) {
+			a.complete(st, k)
+		}
+	}
+}
+
+// Decode adds a decode to its slot. Decodes are ignored until the instance
+// has sent a Status, which tells the T/R period.
+func (a *Aggregator) Decode(at time.Time, d message.DecodeResponse) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	st, ok := a.instances[d.ID]
+	if !ok || st.period == 0 {
+		return
+	}
+
+	k := slotKey{start: slotStart(d, st.period), replay: !d.New}
+
+	// The decodes of a later slot complete the earlier ones whose cycle end was missed.
+	for _, other := range sortedKeys(st.slots) {
+		if other.replay == k.replay && other.start.Before(k.start) {
+			a.complete(st, other)
+		}
+	}
+
+	o, ok := st.slots[k]
+	if !ok {
+		o = &openSlot{batch: &Batch{
+			Instance: d.ID,
+			Start:    k.start,
+			Period:   st.period,
+			Mode:     st.mode,
+			Dial:     st.dial,
+			Band:     band.FromFrequency(st.dial),
+			Replay:   k.replay,
+		}}
+		st.slots[k] = o
+	}
+
+	o.batch.Decodes = append(o.batch.Decodes, d)
+	if d.LowConfidence {
+		o.batch.LowConfidence++
+	}
+
+	o.updated = at
+}
+
+// Flush completes all the open slots of an instance, as when it closes.
+func (a *Aggregator) Flush(id string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	st, ok := a.instances[id]
+	if !ok {
+		return
+	}
+
+	for _, k := range sortedKeys(st.slots) {
+		a.complete(st, k)
+	}
+
+	delete(a.instances, id)
+}
+
+// Run completes the stale slots every second until ctx is done.
+func (a *Aggregator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			a.Expire()
+		}
+	}
+}
+
+// Expire completes the slots two periods after their start, and the replays idle for a second.
+func (a *Aggregator) Expire() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+
+	for _, st := range a.instances {
+		for _, k := range sortedKeys(st.slots) {
+			o := st.slots[k]
+
+			if (k.replay && now.Sub(o.updated) >= replayIdle) ||
+				(!k.replay && !now.Before(k.start.Add(2*o.batch.Period))) {
+				a.complete(st, k)
+			}
+		}
+	}
+}
+
+func (a *Aggregator) instance(id string) *instanceState {
+	st, ok := a.instances[id]
+	if !ok {
+		st = &instanceState{slots: make(map[slotKey]*openSlot)}
+		a.instances[id] = st
+	}
+
+	return st
+}
+
+func (a *Aggregator) complete(st *instanceState, k slotKey) {
+	o := st.slots[k]
+	delete(st.slots, k)
+
+	b := *o.batch
+	b.SNR = snrStats(b.Decodes)
+
+	select {
+	case a.batches <- b:
+	default:
+		a.log.Println("slot aggregator: batch dropped:", b.Instance, b.Start.Format("15:04:05.0"), b.Count(), "decodes")
+	}
+}
+
+// slotStart returns the start of the slot of a decode, on the day of its
+// FullTime. The time is rounded to the nearest slot start, as WSJT-X sends
+// whole seconds: 08:00:07 for the FT4 slot starting at 08:00:07.5.
+func slotStart(d message.DecodeResponse, period time.Duration) time.Time {
+	periodMs := int64(period / time.Millisecond)
+	ms := int64(d.Time) % millisecondsDay
+	day := time.Date(d.FullTime.Year(), d.FullTime.Month(), d.FullTime.Day(), 0, 0, 0, 0, time.UTC)
+
+	return day.Add(time.Duration((ms+periodMs/2)/periodMs*periodMs) * time.Millisecond)
+}
+
+// period returns the T/R period of the status. FT4 sends 7 seconds for its
+// 7.5 seconds period.
+func period(s message.StatusResponse) time.Duration {
+	switch {
+	case s.Mode == "FT4":
+		return ft4Period
+	case s.TRPeriod > 0:
+		return time.Duration(s.TRPeriod) * time.Second
+	default:
+		return defaultPeriod
+	}
+}
+
+func snrStats(decodes []message.DecodeResponse) SNRStats {
+	if len(decodes) == 0 {
+		return SNRStats{}
+	}
+
+	snr := make([]int32, len(decodes))
+	sum := 0.0
+
+	for i, d := range decodes {
+		snr[i] = d.SNR
+		sum += float64(d.SNR)
+	}
+
+	sort.Slice(snr, func(i, j int) bool { return snr[i] < snr[j] })
+
+	s := SNRStats{Min: snr[0], Max: snr[len(snr)-1], Mean: sum / float64(len(snr))}
+
+	if n := len(snr); n%2 == 1 {
+		s.Median = float64(snr[n/2])
+	} else {
+		s.Median = float64(snr[n/2-1]+snr[n/2]) / 2
+	}
+
+	return s
+}
+
+// sortedKeys returns the slots oldest first, the new decodes before the replays.
+func sortedKeys(slots map[slotKey]*openSlot) []slotKey {
+	keys := make([]slotKey, 0, len(slots))
+	for k := range slots {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].start.Equal(keys[j].start) {
+			return keys[i].start.Before(keys[j].start)
+		}
+
+		return !keys[i].replay && keys[j].replay
+	})
+
+	return keys
+}
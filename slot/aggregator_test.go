@@ -0,0 +1,148 @@
+package slot
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func drain(a *Aggregator) []Batch {
+	var batches []Batch
+
+	for {
+		select {
+		case b := <-a.Batches():
+			batches = append(batches, b)
+		default:
+			return batches
+		}
+	}
+}
+
+var day = time.Date(2022, 2, 4, 0, 0, 0, 0, time.UTC)
+
+// decode returns a decode received at ms since midnight.
+func decode(ms uint32, snr int32, text string, isNew bool) message.DecodeResponse {
+	return message.DecodeResponse{ID: "WSJT-X", New: isNew, Time: ms, FullTime: day.Add(time.Duration(ms/1000) * time.Second), SNR: snr, Message: text}
+}
+
+func TestAggregator_FT8(t *testing.T) {
+	a := NewAggregator(log.New(io.Discard, "", 0))
+	slot := day.Add(10*time.Hour + 40*time.Minute)
+	ms := uint32(slot.Sub(day) / time.Millisecond)
+	s := message.StatusResponse{ID: "WSJT-X", Mode: "FT8", Dial: 14074000, TRPeriod: 15}
+
+	a.Decode(slot, decode(ms, -10, "CQ K1ABC FN42", true))
+	a.Status(slot, s)
+
+	// An early decoding pass, ending before the slot is over.
+	s.Decoding = true
+	a.Status(slot.Add(12*time.Second), s)
+	a.Decode(slot.Add(12*time.Second), decode(ms, -10, "CQ K1ABC FN42", true))
+	s.Decoding = false
+	a.Status(slot.Add(13*time.Second), s)
+
+	if got := drain(a); len(got) != 0 {
+		t.Fatalf("early pass completed %d batches, want 0", len(got))
+	}
+
+	s.Decoding = true
+	a.Status(slot.Add(15*time.Second), s)
+	a.Decode(slot.Add(15*time.Second), decode(ms+1, -3, "W9XYZ K1ABC -10", true))
+	a.Decode(slot.Add(15*time.Second), decode(ms+2, -20, "CQ N0DEF EN10", true))
+	a.Decode(slot.Add(15*time.Second), decode(ms, 4, "CQ JA1XYZ PM95", false))
+	s.Decoding = false
+	a.Status(slot.Add(16*time.Second), s)
+
+	got := drain(a)
+	if len(got) != 1 {
+		t.Fatalf("batches = %d, want 1", len(got))
+	}
+
+	b := got[0]
+	if !b.Start.Equal(slot) || b.Period != 15*time.Second || b.Band != "20m" || b.Mode != "FT8" || b.Replay {
+		t.Errorf("batch = %+v", b)
+	}
+
+	if b.Count() != 3 || b.SNR != (SNRStats{Min: -20, Max: -3, Mean: -11, Median: -10}) {
+		t.Errorf("batch count = %d, SNR = %+v", b.Count(), b.SNR)
+	}
+
+	a.now = func() time.Time { return slot.Add(16 * time.Second) }
+	a.Expire()
+
+	got = drain(a)
+	if len(got) != 1 || !got[0].Replay || got[0].Count() != 1 || got[0].SNR.Median != 4 {
+		t.Errorf("replay batches = %+v, want the replayed decode", got)
+	}
+}
+
+func TestAggregator_FT4(t *testing.T) {
+	a := NewAggregator(log.New(io.Discard, "", 0))
+	slot := day.Add(8*time.Hour + 7500*time.Millisecond)
+	ms := uint32(slot.Sub(day) / time.Millisecond)
+
+	// WSJT-X sends the whole seconds of the slot start: 08:00:07 then 08:00:15.
+	a.Status(slot, message.StatusResponse{ID: "WSJT-X", Mode: "FT4", Dial: 7047500, TRPeriod: 7})
+	a.Decode(slot, decode(ms-500, -5, "CQ K1ABC FN42", true))
+	a.Decode(slot, decode(ms+7500, -7, "CQ W9XYZ EN37", true))
+
+	got := drain(a)
+	if len(got) != 1 || !got[0].Start.Equal(slot) || got[0].Period != ft4Period || got[0].Count() != 1 {
+		t.Fatalf("batches = %+v, want the first slot completed by the next", got)
+	}
+
+	a.now = func() time.Time { return slot.Add(3 * ft4Period) }
+	a.Expire()
+
+	got = drain(a)
+	if len(got) != 1 || !got[0].Start.Equal(slot.Add(ft4Period)) {
+		t.Errorf("expired batches = %+v, want the second slot", got)
+	}
+}
+
+func TestAggregator_Flush(t *testing.T) {
+	a := NewAggregator(log.New(io.Discard, "", 0))
+	slot := day.Add(time.Hour)
+	ms := uint32(slot.Sub(day) / time.Millisecond)
+
+	a.Status(slot, message.StatusResponse{ID: "WSJT-X", Mode: "JT65", TRPeriod: 60})
+	a.Decode(slot, decode(ms+1000, -5, "CQ K1ABC FN42", true))
+	a.Decode(slot, decode(ms-59000, -5, "CQ K1ABC FN42", false))
+	a.Flush("WSJT-X")
+
+	got := drain(a)
+	if len(got) != 2 || !got[0].Replay || got[1].Replay || got[1].Period != time.Minute {
+		t.Errorf("batches = %+v, want the older replayed slot then the new one", got)
+	}
+
+	a.Decode(slot, decode(ms, -5, "CQ K1ABC FN42", true))
+	if got := drain(a); len(got) != 0 {
+		t.Errorf("decode after close: %d batches", len(got))
+	}
+}
+
+func TestAggregator_Midnight(t *testing.T) {
+	a := NewAggregator(log.New(io.Discard, "", 0))
+	slot := day.Add(-15 * time.Second)
+	ms := uint32(slot.Sub(day.AddDate(0, 0, -1)) / time.Millisecond)
+	received := day.Add(time.Second)
+	s := message.StatusResponse{ID: "WSJT-X", Mode: "FT8", Dial: 14074000, TRPeriod: 15, Decoding: true}
+
+	a.Status(received, s)
+
+	// The last slot of the day, decoded after midnight: ParseAt dates it yesterday.
+	d := decode(ms, -10, "CQ K1ABC FN42", true)
+	d.FullTime = d.FullTime.AddDate(0, 0, -1)
+	a.Decode(received, d)
+
+	s.Decoding = false
+	a.Status(received.Add(time.Second), s)
+
+	if got := drain(a); len(got) != 1 || !got[0].Start.Equal(slot) {
+		t.Fatalf("batches = %+v, want the slot starting at %s", got, slot)
+	}
+}
@@ -0,0 +1,213 @@
+// Package status turns the consecutive StatusResponse of each WSJT-X instance into change events.
+package status
+
+import (
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const eventsBuffer = 64
+
+// Kind tells what changed between two status messages.
+type Kind int
+
+const (
+	DialChanged Kind = iota
+	BandChanged
+	ModeChanged
+	DXCallChanged
+	DXGridChanged
+	TXEnabled
+	TXDisabled
+	TransmitStarted
+	TransmitStopped
+	DecodingStarted
+	DecodingStopped
+	ConfigurationChanged
+	TXWatchdogTripped
+	SpecialOperationModeChanged
+)
+
+var kindNames = map[Kind]string{
+	DialChanged:                 "dial_changed",
+	BandChanged:                 "band_changed",
+	ModeChanged:                 "mode_changed",
+	DXCallChanged:               "dx_call_changed",
+	DXGridChanged:               "dx_grid_changed",
+	TXEnabled:                   "tx_enabled",
+	TXDisabled:                  "tx_disabled",
+	TransmitStarted:             "transmit_started",
+	TransmitStopped:             "transmit_stopped",
+	DecodingStarted:             "decoding_started",
+	DecodingStopped:             "decoding_stopped",
+	ConfigurationChanged:        "configuration_changed",
+	TXWatchdogTripped:           "tx_watchdog_tripped",
+	SpecialOperationModeChanged: "special_operation_mode_changed",
+}
+
+func (k Kind) String() string {
+	if n, ok := kindNames[k]; ok {
+		return n
+	}
+
+	return "unknown"
+}
+
+// Event is a change between the Previous and the Current status of an instance.
+type Event struct {
+	Kind     Kind
+	ID       string
+	Time     time.Time
+	Previous message.StatusResponse
+	Current  message.StatusResponse
+	// Duration is the length of the transmission for TransmitStopped.
+	Duration time.Duration
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type state struct {
+	last          message.StatusResponse
+	transmitStart time.Time
+}
+
+// Tracker compares the consecutive status messages of each instance.
+type Tracker struct {
+	mu     sync.Mutex
+	states map[string]*state
+	events chan Event
+	log    logger
+}
+
+func NewTracker(logger logger) *Tracker {
+	return &Tracker{
+		states: make(map[string]*state),
+		events: make(chan Event, eventsBuffer),
+		log:    logger,
+	}
+}
+
+// Register feeds the tracker with the status messages dispatched by router.
+// The events are sent on Events.
+func (t *Tracker) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		for _, e := range t.Update(m.Received, s) {
+			select {
+			case t.events <- e:
+			default:
+				t.log.Println("status tracker: event dropped:", e.Kind, e.ID)
+			}
+		}
+	})
+	router.OnClose(func(_ udpserver.Message, c message.CloseResponse) {
+		t.Forget(c.ID)
+	})
+}
+
+// Events returns the events of the status messages dispatched by the router.
+// The channel is buffered and events are dropped when it is full.
+func (t *Tracker) Events() <-chan Event {
+	return t.events
+}
+
+// Update records s, received at the given time, and returns what changed since
+// the previous status of the same instance. The first status of an instance
+// returns no events.
+func (t *Tracker) Update(at time.Time, s message.StatusResponse) []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	st, ok := t.states[s.ID]
+	if !ok {
+		st = &state{last: s}
+		if s.Transmitting {
+			st.transmitStart = at
+		}
+
+		t.states[s.ID] = st
+
+		return nil
+	}
+
+	prev := st.last
+	st.last = s
+
+	var events []Event
+
+	add := func(k Kind) *Event {
+		events = append(events, Event{Kind: k, ID: s.ID, Time: at, Previous: prev, Current: s})
+
+		return &events[len(events)-1]
+	}
+
+	if prev.Dial != s.Dial {
+		add(DialChanged)
+
+		if band.FromFrequency(prev.Dial) != band.FromFrequency(s.Dial) {
+			add(BandChanged)
+		}
+	}
+
+	if prev.Mode != s.Mode || prev.SUBMode != s.SUBMode || prev.TXMode != s.TXMode {
+		add(ModeChanged)
+	}
+
+	if prev.DXCall != s.DXCall {
+		add(DXCallChanged)
+	}
+
+	if prev.DXGrid != s.DXGrid {
+		add(DXGridChanged)
+	}
+
+	if !prev.TXEnabled && s.TXEnabled {
+		add(TXEnabled)
+	} else if prev.TXEnabled && !s.TXEnabled {
+		add(TXDisabled)
+	}
+
+	if !prev.Transmitting && s.Transmitting {
+		st.transmitStart = at
+		add(TransmitStarted)
+	} else if prev.Transmitting && !s.Transmitting {
+		e := add(TransmitStopped)
+		if !st.transmitStart.IsZero() {
+			e.Duration = at.Sub(st.transmitStart)
+		}
+
+		st.transmitStart = time.Time{}
+	}
+
+	if !prev.Decoding && s.Decoding {
+		add(DecodingStarted)
+	} else if prev.Decoding && !s.Decoding {
+		add(DecodingStopped)
+	}
+
+	if prev.ConfigurationName != s.ConfigurationName {
+		add(ConfigurationChanged)
+	}
+
+	if !prev.TXWatchdog && s.TXWatchdog {
+		add(TXWatchdogTripped)
+	}
+
+	if prev.SpecialOperationMode != s.SpecialOperationMode {
+		add(SpecialOperationModeChanged)
+	}
+
+	return events
+}
+
+// Forget drops the state of an instance, for example when it closes.
+func (t *Tracker) Forget(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.states, id)
+}
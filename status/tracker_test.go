@@ -0,0 +1,65 @@
+package status
+
+import (
+	"io"
+	"log"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func kinds(events []Event) []Kind {
+	k := make([]Kind, 0, len(events))
+	for _, e := range events {
+		k = append(k, e.Kind)
+	}
+
+	return k
+}
+
+func TestTracker_Update(t *testing.T) {
+	tr := NewTracker(log.New(io.Discard, "", 0))
+	at := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+	s := message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8", ConfigurationName: "Default", SpecialOperationMode: "NONE"}
+
+	if got := tr.Update(at, s); got != nil {
+		t.Errorf("Update() first status = %v, want no events", kinds(got))
+	}
+
+	tests := []struct {
+		name   string
+		after  time.Duration
+		change func(*message.StatusResponse)
+		want   []Kind
+	}{
+		{name: "same status", change: func(s *message.StatusResponse) {}},
+		{name: "dial on same band", change: func(s *message.StatusResponse) { s.Dial = 14075000 }, want: []Kind{DialChanged}},
+		{name: "band", change: func(s *message.StatusResponse) { s.Dial = 7074000 }, want: []Kind{DialChanged, BandChanged}},
+		{name: "submode", change: func(s *message.StatusResponse) { s.SUBMode = "A" }, want: []Kind{ModeChanged}},
+		{name: "dx", change: func(s *message.StatusResponse) { s.DXCall, s.DXGrid = "K1ABC", "FN42" }, want: []Kind{DXCallChanged, DXGridChanged}},
+		{name: "tx enabled and transmit", change: func(s *message.StatusResponse) { s.TXEnabled, s.Transmitting = true, true }, want: []Kind{TXEnabled, TransmitStarted}},
+		{name: "transmit stop", after: 13 * time.Second, change: func(s *message.StatusResponse) { s.Transmitting = false }, want: []Kind{TransmitStopped}},
+		{name: "decoding", change: func(s *message.StatusResponse) { s.Decoding = true }, want: []Kind{DecodingStarted}},
+		{name: "watchdog", change: func(s *message.StatusResponse) { s.Decoding, s.TXWatchdog, s.TXEnabled = false, true, false }, want: []Kind{TXDisabled, DecodingStopped, TXWatchdogTripped}},
+		{name: "configuration", change: func(s *message.StatusResponse) { s.ConfigurationName, s.SpecialOperationMode = "Fox", "FOX" }, want: []Kind{ConfigurationChanged, SpecialOperationModeChanged}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at = at.Add(tt.after)
+			tt.change(&s)
+
+			got := tr.Update(at, s)
+			if !reflect.DeepEqual(kinds(got), append([]Kind{}, tt.want...)) {
+				t.Errorf("Update() = %v, want %v", kinds(got), tt.want)
+			}
+
+			for _, e := range got {
+				if e.Kind == TransmitStopped && e.Duration != 13*time.Second {
+					t.Errorf("TransmitStopped duration = %s, want 13s", e.Duration)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package udpserver
+
+import "errors"
+
+// ErrTruncated is reported when a datagram is longer than the read buffer.
+var ErrTruncated = errors.New("udpserver: datagram truncated")
+
+// ErrClosed is returned by Write after the server has been closed.
+var ErrClosed = errors.New("udpserver: server closed")
+
+// ErrNoRemote is returned by Write before any WSJT-X instance has been heard.
+var ErrNoRemote = errors.New("udpserver: no remote address")
+
+// ErrRateLimited is returned by Write when too many writes wait for the rate limit.
+var ErrRateLimited = errors.New("udpserver: rate limited")
+
+// ParseError is sent on Errors when a received datagram cannot be parsed.
+type ParseError struct {
+	Err    error
+	Packet Packet
+}
+
+func (e *ParseError) Error() string {
+	return "udpserver: datagram from " + e.Packet.Addr.String() + ": " + e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,73 @@
+package udpserver
+
+import (
+	"strings"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+)
+
+// Filter selects the messages sent to a client of the bridges. Empty fields match everything.
+type Filter struct {
+	// Types are message.Response types, like DECODE.
+	Types    []string `json:"types,omitempty"`
+	Instance string   `json:"instance,omitempty"`
+	// Band is a band name like 20m, taken from the dial frequency of the instance.
+	Band string `json:"band,omitempty"`
+	// Callsign is a glob pattern, like K1* or *ABC, matched against the
+	// callsigns of the message with msgtext.MatchCall.
+	Callsign string `json:"callsign,omitempty"`
+}
+
+// Match reports whether m, sent by an instance on band, passes the filter.
+func (f Filter) Match(m Message, band string) bool {
+	if len(f.Types) > 0 && !contains(f.Types, m.ResponseType) {
+		return false
+	}
+
+	if f.Instance != "" && f.Instance != m.InstanceID() {
+		return false
+	}
+
+	if f.Band != "" && f.Band != band {
+		return false
+	}
+
+	if f.Callsign == "" {
+		return true
+	}
+
+	for _, call := range callsigns(m) {
+		if msgtext.MatchCall(f.Callsign, call) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// callsigns returns the callsigns carried by m; for decodes, every word of the text.
+func callsigns(m Message) []string {
+	switch msg := m.Message.(type) {
+	case message.DecodeResponse:
+		return strings.Fields(msg.Message)
+	case message.WSPRDecodeResponse:
+		return []string{msg.Callsign}
+	case message.StatusResponse:
+		return []string{msg.DXCall, msg.DECall}
+	case message.QSOLoggedResponse:
+		return []string{msg.DXCall}
+	default:
+		return nil
+	}
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
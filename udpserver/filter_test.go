@@ -0,0 +1,45 @@
+package udpserver
+
+import (
+	"testing"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestFilterMatch(t *testing.T) {
+	decode := Message{Response: message.Response{
+		ResponseType: message.DecodeType,
+		Message:      message.DecodeResponse{ID: "WSJT-X", Message: "CQ K1ABC FN42"},
+	}}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		text   string
+		want   bool
+	}{
+		{name: "empty", filter: Filter{}, want: true},
+		{name: "type", filter: Filter{Types: []string{message.StatusType, message.DecodeType}}, want: true},
+		{name: "other type", filter: Filter{Types: []string{message.StatusType}}, want: false},
+		{name: "instance", filter: Filter{Instance: "JTDX"}, want: false},
+		{name: "band", filter: Filter{Band: "20m"}, want: true},
+		{name: "other band", filter: Filter{Band: "40m"}, want: false},
+		{name: "callsign", filter: Filter{Callsign: "k1*"}, want: true},
+		{name: "other callsign", filter: Filter{Callsign: "W9*"}, want: false},
+		{name: "portable prefix", filter: Filter{Callsign: "*K1ABC"}, text: "CQ EA8/K1ABC", want: true},
+		{name: "portable suffix", filter: Filter{Callsign: "K1*"}, text: "W9XYZ K1ABC/P R-05", want: true},
+		{name: "portable suffix only", filter: Filter{Callsign: "*/P"}, text: "W9XYZ K1ABC/P R-05", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := decode
+			if tt.text != "" {
+				m.Message = message.DecodeResponse{ID: "WSJT-X", Message: tt.text}
+			}
+
+			if got := tt.filter.Match(m, "20m"); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
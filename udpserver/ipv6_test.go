@@ -0,0 +1,121 @@
+package udpserver
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestUDPNetwork(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{ip: Localhost, want: "udp4"},
+		{ip: Multicast, want: "udp4"},
+		{ip: LocalhostIPv6, want: "udp6"},
+		{ip: MulticastIPv6LinkLocal + "%eth0", want: "udp6"},
+		{ip: DualStack, want: "udp"},
+		{ip: "", want: "udp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := udpNetwork(tt.ip); got != tt.want {
+				t.Errorf("udpNetwork() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// receive sends a decode from client and checks that server gets it.
+func receive(t *testing.T, server *UDPServer, network string, to *net.UDPAddr) {
+	t.Helper()
+
+	client, err := net.DialUDP(network, nil, to)
+	if err != nil {
+		t.Skip("no route:", err)
+	}
+	defer client.Close()
+
+	if _, err := client.Write(hexToBytes(testDecode)); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case m := <-server.Messages():
+		if m.Addr.Port != client.LocalAddr().(*net.UDPAddr).Port {
+			t.Errorf("Messages() from %s, want %s", m.Addr, client.LocalAddr())
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no datagram received from %s", network)
+	}
+}
+
+func TestNewServer_IPv6Loopback(t *testing.T) {
+	server, err := NewServer(context.Background(), LocalhostIPv6, 0, testLogger)
+	if err != nil {
+		t.Skip("IPv6 not available:", err)
+	}
+	defer server.Close()
+
+	receive(t, server, "udp6", server.LocalAddr().(*net.UDPAddr))
+}
+
+func TestNewServer_DualStack(t *testing.T) {
+	server, err := NewServer(context.Background(), DualStack, 0, testLogger)
+	if err != nil {
+		t.Skip("dual-stack not available:", err)
+	}
+	defer server.Close()
+
+	port := server.LocalAddr().(*net.UDPAddr).Port
+	receive(t, server, "udp4", &net.UDPAddr{IP: net.ParseIP(Localhost), Port: port})
+	receive(t, server, "udp6", &net.UDPAddr{IP: net.ParseIP(LocalhostIPv6), Port: port})
+}
+
+func TestNewServer_IPv6Multicast(t *testing.T) {
+	ifi := multicastInterface()
+	if ifi == nil {
+		t.Skip("no multicast interface")
+	}
+
+	l, err := net.ListenUDP("udp6", &net.UDPAddr{IP: net.IPv6loopback})
+	if err != nil {
+		t.Skip("IPv6 not available:", err)
+	}
+
+	port := l.LocalAddr().(*net.UDPAddr).Port
+	l.Close()
+
+	server, err := NewServer(context.Background(), MulticastIPv6LinkLocal, port, testLogger, WithInterface(ifi.Name))
+	if err != nil {
+		t.Skip("IPv6 multicast not available:", err)
+	}
+	defer server.Close()
+
+	group, err := net.ResolveUDPAddr("udp6", net.JoinHostPort(MulticastIPv6LinkLocal+"%"+ifi.Name, strconv.Itoa(port)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	receive(t, server, "udp6", group)
+}
+
+func multicastInterface() *net.Interface {
+	ifs, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+
+	for _, ifi := range ifs {
+		if ifi.Flags&net.FlagUp != 0 && ifi.Flags&net.FlagMulticast != 0 && ifi.Flags&net.FlagLoopback == 0 {
+			ifi := ifi
+
+			return &ifi
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package udpserver
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+// InstanceID returns the ID of the WSJT-X instance that sent m.
+func (m Message) InstanceID() string {
+	return ResponseID(m.Response)
+}
+
+type jsonMessage struct {
+	Type     string      `json:"type"`
+	Instance string      `json:"instance"`
+	Addr     string      `json:"addr,omitempty"`
+	Received time.Time   `json:"received"`
+	Message  interface{} `json:"message"`
+}
+
+// MarshalJSON encodes m as an object with its type, instance ID, source
+// address, receive time and the JSON of the message.
+func (m Message) MarshalJSON() ([]byte, error) {
+	j := jsonMessage{
+		Type:     m.ResponseType,
+		Instance: m.InstanceID(),
+		Received: m.Received,
+		Message:  m.Message,
+	}
+
+	if m.Addr != nil {
+		j.Addr = m.Addr.String()
+	}
+
+	return json.Marshal(j)
+}
+
+// ResponseID returns the WSJT-X instance ID of a parsed message.
+func ResponseID(resp message.Response) string {
+	switch m := resp.Message.(type) {
+	case message.HeartbeatResponse:
+		return m.ID
+	case message.StatusResponse:
+		return m.ID
+	case message.DecodeResponse:
+		return m.ID
+	case message.ClearResponse:
+		return m.ID
+	case message.QSOLoggedResponse:
+		return m.ID
+	case message.CloseResponse:
+		return m.ID
+	case message.WSPRDecodeResponse:
+		return m.ID
+	case message.LoggedADIFResponse:
+		return m.ID
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,183 @@
+package udpserver
+
+import (
+	"net"
+	"time"
+)
+
+// Backpressure is the policy applied when the consumer does not keep up with the received datagrams.
+type Backpressure int
+
+const (
+	// Block stops reading from the socket until the consumer catches up.
+	// The kernel drops datagrams once its own receive buffer is full.
+	Block Backpressure = iota
+	// DropOldest discards the oldest buffered datagram to make room for the new one.
+	// Without a buffer, WithBuffer(0), nothing is buffered and it behaves like
+	// DropNewest.
+	DropOldest
+	// DropNewest discards the datagram just received.
+	DropNewest
+)
+
+func (b Backpressure) String() string {
+	switch b {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	default:
+		return "unknown"
+	}
+}
+
+type options struct {
+	buffer       int
+	backpressure Backpressure
+	readBuffer   int
+	tap          Tap
+	ifname       string
+	// allowedSources and commandPeers are nil when everybody is allowed.
+	allowedSources []*net.IPNet
+	commandPeers   []*net.IPNet
+	idTimeout      time.Duration
+	rateLimits     map[string]rateLimit
+}
+
+// Option configures a UDPServer.
+type Option func(*options)
+
+// WithBuffer sets how many received datagrams are buffered for the consumer.
+// With 0 a datagram is only delivered to a waiting consumer, and DropOldest
+// drops the new datagram as DropNewest does.
+func WithBuffer(size int) Option {
+	return func(o *options) {
+		if size >= 0 {
+			o.buffer = size
+		}
+	}
+}
+
+// WithReadBuffer sets the size of the socket read buffer, MaxDatagramSize by default.
+// Longer datagrams are counted as truncated and reported on Errors with ErrTruncated.
+func WithReadBuffer(size int) Option {
+	return func(o *options) {
+		if size > 0 && size <= MaxDatagramSize {
+			o.readBuffer = size
+		}
+	}
+}
+
+// Direction tells whether a datagram was received or sent.
+type Direction uint8
+
+const (
+	Inbound Direction = iota
+	Outbound
+)
+
+func (d Direction) String() string {
+	if d == Outbound {
+		return "out"
+	}
+
+	return "in"
+}
+
+// Tap is called with every datagram received, before parsing, and every datagram sent.
+// It runs on the reader and writer goroutines and must not block.
+type Tap func(dir Direction, p Packet)
+
+// WithTap sets a Tap, for example a capture recorder.
+func WithTap(t Tap) Option {
+	return func(o *options) {
+		o.tap = t
+	}
+}
+
+// WithInterface sets the network interface multicast groups are joined on.
+func WithInterface(name string) Option {
+	return func(o *options) {
+		o.ifname = name
+	}
+}
+
+// WithBackpressure sets the policy applied when the buffer is full.
+func WithBackpressure(b Backpressure) Option {
+	return func(o *options) {
+		o.backpressure = b
+	}
+}
+
+// WithAllowedSources only accepts the datagrams from addresses in nets;
+// the others are counted in Status.Rejected and logged. Use ParseCIDRs to
+// build nets. By default every source is accepted.
+func WithAllowedSources(nets ...*net.IPNet) Option {
+	return func(o *options) {
+		o.allowedSources = append([]*net.IPNet{}, nets...)
+	}
+}
+
+// WithCommandPeers only accepts commands, the messages WSJT-X receives but
+// never sends like HaltTx, FreeText or Configure, from addresses in nets.
+// Without nets no peer may send commands. By default every peer may.
+//
+// It only filters the datagrams received by the server: the commands of the
+// bridges, like wsbridge or httpapi, are sent by the server itself and must be
+// protected by the authorization of each bridge.
+func WithCommandPeers(nets ...*net.IPNet) Option {
+	return func(o *options) {
+		o.commandPeers = append([]*net.IPNet{}, nets...)
+	}
+}
+
+// WithRejectIDConflicts rejects the messages carrying the ID of an instance
+// heard from another address within timeout. An instance keeps its ID until
+// it sends Close or stays silent for timeout, so a restarted WSJT-X whose
+// Close was lost is rejected until then.
+func WithRejectIDConflicts(timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout > 0 {
+			o.idTimeout = timeout
+		}
+	}
+}
+
+// WithRateLimit limits the writes of msgType, one of the message.*Type names
+// like message.FreeTextType, to perSecond per instance, with bursts of burst.
+// An empty msgType sets the limit of the types without their own. Writes over
+// the limit wait for their turn; queued HighlightCallsign writes for the same
+// callsign are coalesced so only the latest is sent, the superseded ones
+// returning nil. HaltTx is never limited.
+func WithRateLimit(msgType string, perSecond float64, burst int) Option {
+	return func(o *options) {
+		if perSecond <= 0 {
+			return
+		}
+
+		if burst < 1 {
+			burst = 1
+		}
+
+		if o.rateLimits == nil {
+			o.rateLimits = make(map[string]rateLimit)
+		}
+
+		o.rateLimits[msgType] = rateLimit{rate: perSecond, burst: burst}
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		backpressure: Block,
+		readBuffer:   MaxDatagramSize,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
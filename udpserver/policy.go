@@ -0,0 +1,151 @@
+package udpserver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+// Reasons a datagram is rejected, used as keys of Status.Rejected.
+const (
+	// RejectSource is a datagram from an address outside the allowed sources.
+	RejectSource = "source"
+	// RejectCommand is a command, like HaltTx, from a peer not allowed to send commands.
+	RejectCommand = "command"
+	// RejectIDConflict is a message with the ID of an instance known at another address.
+	RejectIDConflict = "id_conflict"
+)
+
+// ParseCIDRs parses networks like 192.168.1.0/24 or fd00::/8. A plain
+// address is a network of that address alone.
+func ParseCIDRs(cidrs ...string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, c := range cidrs {
+		c = strings.TrimSpace(c)
+
+		if !strings.Contains(c, "/") {
+			ip := net.ParseIP(c)
+			if ip == nil {
+				return nil, fmt.Errorf("udpserver: invalid address %q", c)
+			}
+
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			return nil, fmt.Errorf("udpserver: %w", err)
+		}
+
+		nets = append(nets, n)
+	}
+
+	return nets, nil
+}
+
+// MustParseCIDRs is ParseCIDRs panicking on error, for constant lists.
+func MustParseCIDRs(cidrs ...string) []*net.IPNet {
+	nets, err := ParseCIDRs(cidrs...)
+	if err != nil {
+		panic(err)
+	}
+
+	return nets
+}
+
+// containsIP reports whether ip is in one of nets. IPv4-mapped IPv6 addresses,
+// as received on dual-stack sockets, match IPv4 networks.
+func containsIP(nets []*net.IPNet, ip net.IP) bool {
+	for _, n := range nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// idOwner is the address an instance ID was last heard from.
+type idOwner struct {
+	addr     string
+	lastSeen time.Time
+}
+
+// idGuard remembers which address each instance ID comes from. It is only
+// used by the reader goroutine.
+type idGuard struct {
+	timeout time.Duration
+	owners  map[string]idOwner
+	swept   time.Time
+}
+
+// check reports whether resp, received in p, may use its ID, and records the owner.
+// An ID is released when its owner closes or after timeout without messages.
+func (g *idGuard) check(p Packet, resp message.Response) bool {
+	id := ResponseID(resp)
+	if id == "" {
+		return true
+	}
+
+	g.sweep(p.Received)
+
+	addr := p.Addr.String()
+
+	if o, ok := g.owners[id]; ok && o.addr != addr && p.Received.Sub(o.lastSeen) < g.timeout {
+		return false
+	}
+
+	if resp.ResponseType == message.CloseType {
+		delete(g.owners, id)
+
+		return true
+	}
+
+	g.owners[id] = idOwner{addr: addr, lastSeen: p.Received}
+
+	return true
+}
+
+// sweep forgets the owners silent for timeout, at most once per timeout.
+func (g *idGuard) sweep(now time.Time) {
+	if now.Sub(g.swept) < g.timeout {
+		return
+	}
+
+	g.swept = now
+
+	for id, o := range g.owners {
+		if now.Sub(o.lastSeen) >= g.timeout {
+			delete(g.owners, id)
+		}
+	}
+}
+
+// reject returns why p must be rejected before parsing, or "".
+func (u *UDPServer) reject(p Packet) string {
+	if u.opts.allowedSources != nil && !containsIP(u.opts.allowedSources, p.Addr.IP) {
+		return RejectSource
+	}
+
+	if u.opts.commandPeers != nil && !containsIP(u.opts.commandPeers, p.Addr.IP) && message.IsCommand(p.Data) {
+		return RejectCommand
+	}
+
+	return ""
+}
+
+func (u *UDPServer) rejected(p Packet, reason string) {
+	u.stats.RecordRejected(reason)
+	u.log.Println("rejected datagram from", p.Addr, "("+reason+")")
+}
@@ -0,0 +1,156 @@
+package udpserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+// scriptTransport returns its datagrams in order, then blocks until closed.
+type scriptTransport struct {
+	datagrams chan Packet
+	done      chan struct{}
+	once      sync.Once
+}
+
+func newScriptTransport(packets ...Packet) *scriptTransport {
+	t := &scriptTransport{datagrams: make(chan Packet, len(packets)), done: make(chan struct{})}
+	for _, p := range packets {
+		t.datagrams <- p
+	}
+
+	return t
+}
+
+func (t *scriptTransport) ReadFrom(p []byte) (int, *net.UDPAddr, error) {
+	select {
+	case d := <-t.datagrams:
+		return copy(p, d.Data), d.Addr, nil
+	case <-t.done:
+		return 0, nil, net.ErrClosed
+	}
+}
+
+func (t *scriptTransport) WriteTo(p []byte, _ *net.UDPAddr) (int, error) { return len(p), nil }
+
+func (t *scriptTransport) Close() error {
+	t.once.Do(func() { close(t.done) })
+
+	return nil
+}
+
+func (t *scriptTransport) LocalAddr() net.Addr { return &net.UDPAddr{} }
+
+func TestParseCIDRs(t *testing.T) {
+	nets, err := ParseCIDRs("192.168.1.0/24", "10.0.0.1", "fd00::/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "192.168.1.20", want: true},
+		{ip: "::ffff:192.168.1.20", want: true},
+		{ip: "192.168.2.1", want: false},
+		{ip: "10.0.0.1", want: true},
+		{ip: "10.0.0.2", want: false},
+		{ip: "fd12::1", want: true},
+	}
+	for _, tt := range tests {
+		if got := containsIP(nets, net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("containsIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	if _, err := ParseCIDRs("10.0.0.0/33"); err == nil {
+		t.Error("ParseCIDRs(10.0.0.0/33) accepted")
+	}
+}
+
+func TestServerRejects(t *testing.T) {
+	wsjtx := &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 55000}
+	spoofer := &net.UDPAddr{IP: net.ParseIP("192.168.1.66"), Port: 55000}
+	outsider := &net.UDPAddr{IP: net.ParseIP("10.1.1.1"), Port: 55000}
+	controller := &net.UDPAddr{IP: net.ParseIP("192.168.1.2"), Port: 40000}
+
+	heartbeat := message.EncodeHearthBeat(message.HeartbeatMessage{ID: "WSJT-X", MaxSchemaNumber: 3})
+	haltTX := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X"})
+
+	conn := newScriptTransport(
+		Packet{Data: heartbeat, Addr: wsjtx},
+		Packet{Data: heartbeat, Addr: outsider},
+		Packet{Data: heartbeat, Addr: spoofer},
+		Packet{Data: haltTX, Addr: spoofer},
+		Packet{Data: haltTX, Addr: controller},
+		Packet{Data: message.EncodeClose(message.CloseMessage{ID: "WSJT-X"}), Addr: wsjtx},
+		Packet{Data: heartbeat, Addr: spoofer},
+	)
+
+	server := NewServerWithTransport(context.Background(), conn, testLogger,
+		WithAllowedSources(MustParseCIDRs("192.168.1.0/24")...),
+		WithCommandPeers(MustParseCIDRs("192.168.1.2")...),
+		WithRejectIDConflicts(time.Minute),
+	)
+	defer server.Close()
+
+	raw := server.Read()
+
+	var got []string
+
+	for i := 0; i < 4; i++ {
+		select {
+		case data := <-raw:
+			resp, err := message.Parse(data)
+			if err != nil {
+				got = append(got, "command")
+
+				continue
+			}
+
+			got = append(got, resp.ResponseType)
+		case <-time.After(time.Second):
+			t.Fatalf("received %v, want 4 datagrams", got)
+		}
+	}
+
+	want := []string{message.HeartbeatType, "command", message.CloseType, message.HeartbeatType}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("received %v, want %v", got, want)
+		}
+	}
+
+	s := server.GetStatus()
+	if s.Rejected[RejectSource] != 1 || s.Rejected[RejectCommand] != 1 || s.Rejected[RejectIDConflict] != 1 {
+		t.Errorf("Rejected = %v", s.Rejected)
+	}
+
+	if _, ok := s.Remotes[outsider.String()]; ok || s.RxMessages != 4 {
+		t.Errorf("rejected datagrams counted: RxMessages = %d, Remotes = %v", s.RxMessages, s.Remotes)
+	}
+}
+
+func TestIDGuardExpires(t *testing.T) {
+	g := &idGuard{timeout: time.Minute, owners: make(map[string]idOwner)}
+	at := time.Date(2022, 2, 4, 10, 0, 0, 0, time.UTC)
+	heartbeat := func(id string) message.Response {
+		return message.Response{ResponseType: message.HeartbeatType, Message: message.HeartbeatResponse{ID: id}}
+	}
+
+	for i := 0; i < 10; i++ {
+		g.check(Packet{Addr: &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 55000 + i}, Received: at}, heartbeat(fmt.Sprint("WSJT-X ", i)))
+	}
+
+	g.check(Packet{Addr: &net.UDPAddr{IP: net.ParseIP("192.168.1.11"), Port: 55000}, Received: at.Add(2 * time.Minute)}, heartbeat("JTDX"))
+
+	if len(g.owners) != 1 {
+		t.Errorf("owners = %v, want the silent instances forgotten", g.owners)
+	}
+}
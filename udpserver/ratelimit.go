@@ -0,0 +1,215 @@
+package udpserver
+
+import (
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+// maxQueued is the number of writes waiting for a token in each bucket;
+// more writes fail with ErrRateLimited.
+const maxQueued = 64
+
+// rateLimit is a token bucket configuration: rate tokens per second, up to burst.
+type rateLimit struct {
+	rate  float64
+	burst int
+}
+
+type bucketKey struct {
+	instance string
+	msgType  string
+}
+
+type queuedWrite struct {
+	writeRequest
+	// callsign is set for HighlightCallsign, to coalesce the updates.
+	callsign string
+}
+
+type bucket struct {
+	limit  rateLimit
+	tokens float64
+	last   time.Time
+	queue  []queuedWrite
+}
+
+func (b *bucket) refill(now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * b.limit.rate
+	if burst := float64(b.limit.burst); b.tokens > burst {
+		b.tokens = burst
+	}
+
+	b.last = now
+}
+
+// limiter applies the rate limits of the writes. It is only used by the writer goroutine.
+type limiter struct {
+	limits  map[string]rateLimit
+	buckets map[bucketKey]*bucket
+}
+
+func newLimiter(limits map[string]rateLimit) *limiter {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	return &limiter{limits: limits, buckets: make(map[bucketKey]*bucket)}
+}
+
+// submit returns the writes to send now: w, unless it has to wait for a token.
+// Writes that are never sent are answered on their result channel.
+func (l *limiter) submit(w writeRequest, now time.Time) []writeRequest {
+	l.prune(now)
+
+	h, err := message.Peek(w.data)
+	if err != nil || h.Type == message.HaltTxType {
+		return []writeRequest{w}
+	}
+
+	limit, ok := l.limits[h.Type]
+	if !ok {
+		if limit, ok = l.limits[""]; !ok {
+			return []writeRequest{w}
+		}
+	}
+
+	instance := h.ID
+	if instance == "" && w.addr != nil {
+		instance = w.addr.String()
+	}
+
+	key := bucketKey{instance: instance, msgType: h.Type}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: float64(limit.burst), last: now}
+		l.buckets[key] = b
+	}
+
+	b.refill(now)
+
+	// A write given up by its caller does not take a token.
+	if err := w.ctx.Err(); err != nil {
+		w.result <- err
+
+		return nil
+	}
+
+	if len(b.queue) == 0 && b.tokens >= 1 {
+		b.tokens--
+
+		return []writeRequest{w}
+	}
+
+	q := queuedWrite{writeRequest: w}
+
+	if h.Type == message.HighlightCallsignType {
+		if hl, err := message.ParseHighlightCallsign(w.data); err == nil {
+			q.callsign = hl.Callsign
+		}
+
+		for i := range b.queue {
+			if q.callsign != "" && b.queue[i].callsign == q.callsign {
+				// The queued update is superseded and never sent.
+				b.queue[i].result <- nil
+				b.queue[i] = q
+
+				return nil
+			}
+		}
+	}
+
+	if len(b.queue) >= maxQueued {
+		w.result <- ErrRateLimited
+
+		return nil
+	}
+
+	b.queue = append(b.queue, q)
+
+	return nil
+}
+
+// ready returns the queued writes whose bucket has tokens again.
+func (l *limiter) ready(now time.Time) []writeRequest {
+	var out []writeRequest
+
+	for _, b := range l.buckets {
+		if len(b.queue) == 0 {
+			continue
+		}
+
+		b.refill(now)
+
+		for len(b.queue) > 0 && b.tokens >= 1 {
+			w := b.queue[0].writeRequest
+			b.queue = b.queue[1:]
+
+			if err := w.ctx.Err(); err != nil {
+				w.result <- err
+
+				continue
+			}
+
+			b.tokens--
+			out = append(out, w)
+		}
+	}
+
+	return out
+}
+
+// prune forgets the buckets without queued writes that are full again, as a
+// new bucket would be, so that the instances gone do not keep theirs.
+func (l *limiter) prune(now time.Time) {
+	for k, b := range l.buckets {
+		if len(b.queue) > 0 {
+			continue
+		}
+
+		b.refill(now)
+
+		if b.tokens >= float64(b.limit.burst) {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// next returns how long until a queued write can be sent, false if none is queued.
+func (l *limiter) next(now time.Time) (time.Duration, bool) {
+	var (
+		wait  time.Duration
+		found bool
+	)
+
+	for _, b := range l.buckets {
+		if len(b.queue) == 0 {
+			continue
+		}
+
+		b.refill(now)
+
+		d := time.Duration((1 - b.tokens) / b.limit.rate * float64(time.Second))
+		if d < 0 {
+			d = 0
+		}
+
+		if !found || d < wait {
+			wait, found = d, true
+		}
+	}
+
+	return wait, found
+}
+
+// drain answers the queued writes with err.
+func (l *limiter) drain(err error) {
+	for _, b := range l.buckets {
+		for _, q := range b.queue {
+			q.result <- err
+		}
+
+		b.queue = nil
+	}
+}
@@ -0,0 +1,189 @@
+package udpserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func newWrite(data []byte) writeRequest {
+	return writeRequest{ctx: context.Background(), data: data, result: make(chan error, 1)}
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(map[string]rateLimit{message.FreeTextType: {rate: 2, burst: 2}})
+	now := time.Now()
+
+	freeText := func(id string) writeRequest {
+		return newWrite(message.EncodeFreeText(message.FreeTextMessage{ID: id, Text: "CQ"}))
+	}
+
+	for i := 0; i < 2; i++ {
+		if got := l.submit(freeText("WSJT-X"), now); len(got) != 1 {
+			t.Fatalf("write %d within burst queued", i)
+		}
+	}
+
+	queued := freeText("WSJT-X")
+	if got := l.submit(queued, now); len(got) != 0 {
+		t.Fatal("write over burst sent")
+	}
+
+	// Buckets are per instance and unlimited types pass.
+	if got := l.submit(freeText("JTDX"), now); len(got) != 1 {
+		t.Error("other instance limited")
+	}
+
+	if got := l.submit(newWrite(message.EncodeReplay(message.ReplayMessage{ID: "WSJT-X"})), now); len(got) != 1 {
+		t.Error("unlimited type queued")
+	}
+
+	if d, ok := l.next(now); !ok || d != 500*time.Millisecond {
+		t.Errorf("next() = %s, %v, want 500ms", d, ok)
+	}
+
+	if got := l.ready(now.Add(500 * time.Millisecond)); len(got) != 1 || &got[0].data[0] != &queued.data[0] {
+		t.Errorf("ready() = %d writes", len(got))
+	}
+
+	if _, ok := l.next(now); ok {
+		t.Error("next() with empty queues")
+	}
+}
+
+func TestLimiterCancelledAndIdle(t *testing.T) {
+	l := newLimiter(map[string]rateLimit{message.FreeTextType: {rate: 1, burst: 1}})
+	now := time.Now()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	freeText := func(ctx context.Context, id string) writeRequest {
+		w := newWrite(message.EncodeFreeText(message.FreeTextMessage{ID: id, Text: "CQ"}))
+		w.ctx = ctx
+
+		return w
+	}
+
+	cancelled := freeText(ctx, "WSJT-X")
+	if got := l.submit(cancelled, now); len(got) != 0 || <-cancelled.result != context.Canceled {
+		t.Fatal("cancelled write sent")
+	}
+
+	if got := l.submit(freeText(context.Background(), "WSJT-X"), now); len(got) != 1 {
+		t.Fatal("cancelled write took the token")
+	}
+
+	// A queued write cancelled while waiting is answered without a token.
+	queuedCtx, cancelQueued := context.WithCancel(context.Background())
+	queued := freeText(queuedCtx, "WSJT-X")
+	l.submit(queued, now)
+	cancelQueued()
+
+	if got := l.ready(now.Add(time.Second)); len(got) != 0 || <-queued.result != context.Canceled {
+		t.Fatal("cancelled queued write sent")
+	}
+
+	if got := l.submit(freeText(context.Background(), "WSJT-X"), now.Add(time.Second)); len(got) != 1 {
+		t.Fatal("cancelled queued write took the token")
+	}
+
+	// The buckets are forgotten once full again.
+	l.submit(freeText(context.Background(), "JTDX"), now.Add(time.Second))
+
+	if len(l.buckets) != 2 {
+		t.Fatalf("%d buckets, want 2", len(l.buckets))
+	}
+
+	l.submit(newWrite(message.EncodeReplay(message.ReplayMessage{ID: "WSJT-X"})), now.Add(3*time.Second))
+
+	if len(l.buckets) != 0 {
+		t.Errorf("%d idle buckets kept", len(l.buckets))
+	}
+}
+
+func TestLimiterCoalescesHighlights(t *testing.T) {
+	l := newLimiter(map[string]rateLimit{"": {rate: 1, burst: 1}})
+	now := time.Now()
+
+	highlight := func(call string, red uint16) writeRequest {
+		return newWrite(message.EncodeHighlightCallsign(message.HighlightCallsignMessage{
+			ID:              "WSJT-X",
+			Callsign:        call,
+			BackgroundColor: message.QColor{Alpha: message.AlphaOpaque, Red: red},
+		}))
+	}
+
+	l.submit(highlight("K1ABC", 1), now)
+
+	first := highlight("K1ABC", 2)
+	l.submit(first, now)
+	l.submit(highlight("W1AW", 3), now)
+
+	last := highlight("K1ABC", 4)
+	l.submit(last, now)
+
+	select {
+	case err := <-first.result:
+		if err != nil {
+			t.Errorf("superseded write = %v", err)
+		}
+	default:
+		t.Error("superseded write not answered")
+	}
+
+	got := append(l.ready(now.Add(time.Second)), l.ready(now.Add(2*time.Second))...)
+	if len(got) != 2 {
+		t.Fatalf("ready() = %d writes, want 2", len(got))
+	}
+
+	if hl, _ := message.ParseHighlightCallsign(got[0].data); hl.Callsign != "K1ABC" || hl.BackgroundColor.Red != 4 {
+		t.Errorf("first sent = %+v, want the latest K1ABC update", hl)
+	}
+
+	if hl, _ := message.ParseHighlightCallsign(got[1].data); hl.Callsign != "W1AW" {
+		t.Errorf("second sent = %+v", hl)
+	}
+}
+
+func TestWriteRateLimitHaltTXExempt(t *testing.T) {
+	serverAddr := &net.UDPAddr{IP: net.ParseIP(Localhost), Port: DefaultPort}
+	wsjtxAddr := &net.UDPAddr{IP: net.ParseIP(Localhost), Port: 55000}
+	serverEnd, wsjtx := NewPipe(serverAddr, wsjtxAddr)
+	defer wsjtx.Close()
+
+	server := NewServerWithTransport(context.Background(), serverEnd, testLogger, WithRateLimit("", 0.001, 1))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	freeText := message.EncodeFreeText(message.FreeTextMessage{ID: "WSJT-X", Text: "CQ"})
+	if err := server.WriteTo(ctx, freeText, wsjtxAddr); err != nil {
+		t.Fatal(err)
+	}
+
+	queued := make(chan error, 1)
+
+	go func() {
+		queued <- server.WriteTo(ctx, freeText, wsjtxAddr)
+	}()
+
+	haltTX := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X"})
+	for i := 0; i < 3; i++ {
+		if err := server.WriteTo(ctx, haltTX, wsjtxAddr); err != nil {
+			t.Fatalf("HaltTx %d: %v", i, err)
+		}
+	}
+
+	if err := <-queued; err != context.DeadlineExceeded {
+		t.Errorf("limited write = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if s := server.GetStatus(); s.TxMessages != 4 {
+		t.Errorf("TxMessages = %d, want 4", s.TxMessages)
+	}
+}
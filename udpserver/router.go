@@ -0,0 +1,241 @@
+package udpserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+const defaultIsolatedQueue = 64
+
+// Router dispatches parsed messages to the handlers registered for their type.
+// Handlers run on the dispatching goroutine unless they are registered with
+// Isolated. A panicking handler is recovered and logged.
+type Router struct {
+	mu      sync.RWMutex
+	routes  map[string][]*route
+	all     []*route
+	onError []func(error)
+	log     logger
+	wg      sync.WaitGroup
+}
+
+type route struct {
+	handle func(Message)
+	queue  chan Message
+}
+
+// HandlerOption configures a handler registered on the Router.
+type HandlerOption func(*route)
+
+// Isolated runs the handler on its own goroutine with a queue of queueSize messages.
+// Messages are dropped when the queue is full, so a slow handler never stalls the others.
+func Isolated(queueSize int) HandlerOption {
+	if queueSize <= 0 {
+		queueSize = defaultIsolatedQueue
+	}
+
+	return func(r *route) {
+		r.queue = make(chan Message, queueSize)
+	}
+}
+
+func NewRouter(logger logger) *Router {
+	return &Router{
+		routes: make(map[string][]*route),
+		log:    logger,
+	}
+}
+
+// Handle registers h for messages with the given message.Response type.
+func (r *Router) Handle(responseType string, h func(Message), opts ...HandlerOption) {
+	rt := r.newRoute(h, opts)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes[responseType] = append(r.routes[responseType], rt)
+}
+
+// HandleAll registers h for messages of any type.
+func (r *Router) HandleAll(h func(Message), opts ...HandlerOption) {
+	rt := r.newRoute(h, opts)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.all = append(r.all, rt)
+}
+
+func (r *Router) newRoute(h func(Message), opts []HandlerOption) *route {
+	rt := &route{handle: h}
+	for _, opt := range opts {
+		opt(rt)
+	}
+
+	if rt.queue != nil {
+		r.wg.Add(1)
+
+		go r.worker(rt)
+	}
+
+	return rt
+}
+
+func (r *Router) OnHeartbeat(h func(Message, message.HeartbeatResponse), opts ...HandlerOption) {
+	r.Handle(message.HeartbeatType, func(m Message) {
+		h(m, m.Message.(message.HeartbeatResponse))
+	}, opts...)
+}
+
+func (r *Router) OnStatus(h func(Message, message.StatusResponse), opts ...HandlerOption) {
+	r.Handle(message.StatusType, func(m Message) {
+		h(m, m.Message.(message.StatusResponse))
+	}, opts...)
+}
+
+func (r *Router) OnDecode(h func(Message, message.DecodeResponse), opts ...HandlerOption) {
+	r.Handle(message.DecodeType, func(m Message) {
+		h(m, m.Message.(message.DecodeResponse))
+	}, opts...)
+}
+
+func (r *Router) OnClear(h func(Message, message.ClearResponse), opts ...HandlerOption) {
+	r.Handle(message.ClearType, func(m Message) {
+		h(m, m.Message.(message.ClearResponse))
+	}, opts...)
+}
+
+func (r *Router) OnQSOLogged(h func(Message, message.QSOLoggedResponse), opts ...HandlerOption) {
+	r.Handle(message.QSOLoggedType, func(m Message) {
+		h(m, m.Message.(message.QSOLoggedResponse))
+	}, opts...)
+}
+
+func (r *Router) OnClose(h func(Message, message.CloseResponse), opts ...HandlerOption) {
+	r.Handle(message.CloseType, func(m Message) {
+		h(m, m.Message.(message.CloseResponse))
+	}, opts...)
+}
+
+func (r *Router) OnWSPRDecode(h func(Message, message.WSPRDecodeResponse), opts ...HandlerOption) {
+	r.Handle(message.WSPRDecodeType, func(m Message) {
+		h(m, m.Message.(message.WSPRDecodeResponse))
+	}, opts...)
+}
+
+func (r *Router) OnLoggedADIF(h func(Message, message.LoggedADIFResponse), opts ...HandlerOption) {
+	r.Handle(message.LoggedADIFType, func(m Message) {
+		h(m, m.Message.(message.LoggedADIFResponse))
+	}, opts...)
+}
+
+// OnError registers h for the errors read by Serve.
+func (r *Router) OnError(h func(error)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.onError = append(r.onError, h)
+}
+
+// Dispatch delivers m to every handler registered for its type.
+func (r *Router) Dispatch(m Message) {
+	r.mu.RLock()
+	typed := r.routes[m.ResponseType]
+	routes := make([]*route, 0, len(typed)+len(r.all))
+	routes = append(append(routes, typed...), r.all...)
+	r.mu.RUnlock()
+
+	for _, rt := range routes {
+		if rt.queue == nil {
+			r.call(rt.handle, m)
+
+			continue
+		}
+
+		select {
+		case rt.queue <- m:
+		default:
+			r.log.Println("router: queue full, message dropped:", m.ResponseType)
+		}
+	}
+}
+
+// Serve dispatches the messages and errors of u until ctx is done or the server is closed.
+func (r *Router) Serve(ctx context.Context, u *UDPServer) error {
+	return r.Run(ctx, u.Messages(), u.Errors())
+}
+
+// Run dispatches msgs and errs until ctx is done or msgs is closed.
+func (r *Router) Run(ctx context.Context, msgs <-chan Message, errs <-chan error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case m, ok := <-msgs:
+			if !ok {
+				return nil
+			}
+
+			r.Dispatch(m)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+
+				continue
+			}
+
+			r.dispatchError(err)
+		}
+	}
+}
+
+// Close stops the isolated handlers after they have drained their queues.
+// No handler may be registered or dispatched to after Close.
+func (r *Router) Close() {
+	r.mu.Lock()
+	for _, routes := range r.routes {
+		closeQueues(routes)
+	}
+
+	closeQueues(r.all)
+	r.mu.Unlock()
+
+	r.wg.Wait()
+}
+
+func (r *Router) dispatchError(err error) {
+	r.mu.RLock()
+	handlers := r.onError
+	r.mu.RUnlock()
+
+	for _, h := range handlers {
+		h := h
+		r.call(func(Message) { h(err) }, Message{})
+	}
+}
+
+func (r *Router) worker(rt *route) {
+	defer r.wg.Done()
+
+	for m := range rt.queue {
+		r.call(rt.handle, m)
+	}
+}
+
+func closeQueues(routes []*route) {
+	for _, rt := range routes {
+		if rt.queue != nil {
+			close(rt.queue)
+		}
+	}
+}
+
+func (r *Router) call(h func(Message), m Message) {
+	defer func() {
+		if p := recover(); p != nil {
+			r.log.Println("router: handler panic:", fmt.Sprint(p))
+		}
+	}()
+
+	h(m)
+}
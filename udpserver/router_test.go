@@ -0,0 +1,54 @@
+package udpserver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestRouter_Dispatch(t *testing.T) {
+	r := NewRouter(testLogger)
+
+	var (
+		mu       sync.Mutex
+		decodes  []string
+		statuses int
+	)
+
+	r.OnDecode(func(m Message, d message.DecodeResponse) {
+		panic("handler failure")
+	})
+	r.OnDecode(func(m Message, d message.DecodeResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		decodes = append(decodes, d.Message)
+	})
+	r.OnStatus(func(m Message, s message.StatusResponse) {
+		mu.Lock()
+		defer mu.Unlock()
+		statuses++
+	}, Isolated(1))
+
+	r.Dispatch(Message{Response: message.Response{
+		ResponseType: message.DecodeType,
+		Message:      message.DecodeResponse{Message: "CQ IU5PMP JN53"},
+	}})
+	r.Dispatch(Message{Response: message.Response{
+		ResponseType: message.StatusType,
+		Message:      message.StatusResponse{},
+	}})
+	r.Dispatch(Message{Response: message.Response{
+		ResponseType: message.HeartbeatType,
+		Message:      message.HeartbeatResponse{},
+	}})
+	r.Close()
+
+	if len(decodes) != 1 || decodes[0] != "CQ IU5PMP JN53" {
+		t.Errorf("decode handler got %v", decodes)
+	}
+
+	if statuses != 1 {
+		t.Errorf("isolated status handler called %d times, want 1", statuses)
+	}
+}
@@ -2,111 +2,168 @@ package udpserver
 
 import (
 	"context"
-	"log"
 	"net"
 	"sync"
 	"time"
+
+	"github.com/logocomune/wsjtx/message"
 )
 
 const (
 	Localhost   = "127.0.0.1"
 	Multicast   = "224.0.0.101"
 	DefaultPort = 2237
+
+	LocalhostIPv6 = "::1"
+	// MulticastIPv6LinkLocal and MulticastIPv6SiteLocal are the IPv6 counterparts of Multicast.
+	// Link-local groups need an interface, given as a zone or with WithInterface.
+	MulticastIPv6LinkLocal = "ff02::101"
+	MulticastIPv6SiteLocal = "ff05::101"
+	// DualStack listens on all the IPv4 and IPv6 addresses.
+	DualStack = "::"
+
+	// MaxDatagramSize is the largest UDP payload.
+	MaxDatagramSize = 65535
+
+	errorsBuffer = 16
 )
 
 type UDPServer struct {
-	conn   *net.UDPConn
-	start  time.Time
-	remote *net.UDPAddr
-	ctx    context.Context
-	r      chan []byte
-	w      chan []byte
-	log    logger
-	wg     sync.WaitGroup
-	cancel context.CancelFunc
-	stats  stats
+	conn    PacketTransport
+	start   time.Time
+	remote  *net.UDPAddr
+	ctx     context.Context
+	packets chan datagram
+	r       chan []byte
+	msgs    chan Message
+	errs    chan error
+	w       chan writeRequest
+	log     logger
+	wg      sync.WaitGroup
+	cancel  context.CancelFunc
+	stats   *stats
+	rawOnce sync.Once
+	msgOnce sync.Once
+	// rClose and msgsClose close r and msgs, at the end of the input or on Close.
+	rClose    sync.Once
+	msgsClose sync.Once
+	opts      options
+	mu        sync.Mutex
+	closed    bool
+	once      sync.Once
 }
 
-type stats struct {
-	rxMessages int64
-	txMessages int64
-	started    time.Time
-	sync.RWMutex
-}
-
-type Status struct {
-	RxMessages int64
-	TxMessages int64
-	Started    time.Time
-	Uptime     time.Duration
+type writeRequest struct {
+	ctx    context.Context
+	data   []byte
+	addr   *net.UDPAddr
+	result chan error
 }
 
-func (s *stats) IncrRxMessages() {
-	s.Lock()
-	defer s.Unlock()
-	s.rxMessages++
+// Packet is a datagram as received from WSJT-X.
+type Packet struct {
+	Data     []byte
+	Addr     *net.UDPAddr
+	Received time.Time
 }
 
-func (s *stats) IncrTxMessages() {
-	s.Lock()
-	defer s.Unlock()
-	s.txMessages++
+// datagram is a Packet with the result of its parsing.
+type datagram struct {
+	Packet
+	resp message.Response
+	err  error
 }
 
-func (s *stats) GetStats() Status {
-	s.RLock()
-	defer s.RUnlock()
-	return Status{
-		RxMessages: s.rxMessages,
-		TxMessages: s.txMessages,
-		Uptime:     time.Since(s.started),
-		Started:    s.started,
-	}
+// Message is a parsed datagram together with its source address and receive time.
+type Message struct {
+	message.Response
+	Addr     *net.UDPAddr
+	Received time.Time
 }
 
 type logger interface {
 	Println(v ...interface{})
 }
 
-func NewServer(ctx context.Context, ip string, port int, logger logger) (*UDPServer, error) {
-	conn, err := net.ListenUDP("udp", &net.UDPAddr{
-		IP:   net.ParseIP(ip),
-		Port: port,
-	})
+func NewServer(ctx context.Context, ip string, port int, logger logger, opts ...Option) (*UDPServer, error) {
+	conn, err := ListenMulticastUDP(ip, port, newOptions(opts).ifname)
 	if err != nil {
 		return nil, err
 	}
+
+	return NewServerWithTransport(ctx, conn, logger, opts...), nil
+}
+
+// NewServerWithTransport creates a server reading and writing datagrams through t.
+// The server owns t and closes it on Close.
+func NewServerWithTransport(ctx context.Context, conn PacketTransport, logger logger, opts ...Option) *UDPServer {
+	o := newOptions(opts)
 	ctx, cancel := context.WithCancel(ctx)
 	u := UDPServer{
-		conn:   conn,
-		start:  time.Now(),
-		w:      make(chan []byte),
-		r:      make(chan []byte),
-		ctx:    ctx,
-		log:    logger,
-		cancel: cancel,
-		stats: stats{
-			started: time.Now(),
-		},
+		conn:    conn,
+		start:   time.Now(),
+		w:       make(chan writeRequest),
+		r:       make(chan []byte),
+		packets: make(chan datagram, o.buffer),
+		msgs:    make(chan Message),
+		errs:    make(chan error, errorsBuffer),
+		ctx:     ctx,
+		log:     logger,
+		cancel:  cancel,
+		opts:    o,
+		stats:   newStats(),
 	}
 	u.wg.Add(2)
 	go u.reader()
 	go u.writer()
-	return &u, nil
+	return &u
 }
 
+// Close stops the server and waits for its goroutines to exit.
+// It is safe to call more than once and while reads and writes are in flight.
 func (u *UDPServer) Close() {
-	u.cancel()
-	u.conn.Close()
+	u.once.Do(func() {
+		u.mu.Lock()
+		u.closed = true
+		u.mu.Unlock()
+
+		u.cancel()
+		u.conn.Close()
+
+		u.wg.Wait()
+		u.rClose.Do(func() { close(u.r) })
+		u.msgsClose.Do(func() { close(u.msgs) })
+		close(u.errs)
+	})
+}
+
+// spawn runs f on its own goroutine unless the server is closed.
+func (u *UDPServer) spawn(f func()) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.closed {
+		return
+	}
+
+	u.wg.Add(1)
 
-	u.wg.Wait()
-	close(u.r)
-	close(u.w)
+	go func() {
+		defer u.wg.Done()
+		f()
+	}()
 }
 
 func (u *UDPServer) reader() error {
 	defer u.wg.Done()
 
+	buf := make([]byte, u.opts.readBuffer+1)
+
+	var ids *idGuard
+	if u.opts.idTimeout > 0 {
+		ids = &idGuard{timeout: u.opts.idTimeout, owners: make(map[string]idOwner)}
+	}
+
 	for {
 		select {
 		case <-u.ctx.Done():
@@ -115,53 +172,342 @@ func (u *UDPServer) reader() error {
 			return nil
 		default:
 		}
-		buf := make([]byte, 1024)
-		rlen, addr, err := u.conn.ReadFromUDP(buf)
+		rlen, addr, received, err := u.readFrom(buf)
 		if err != nil {
-			u.cancel()
+			if u.ctx.Err() != nil {
+				u.log.Println("reader: closing")
+
+				return nil
+			}
+
+			// The end of the input, like io.EOF of a replayed capture: the
+			// pumps deliver the datagrams still queued, then close their channels.
 			u.log.Println("close reader:", err)
+			close(u.packets)
+
 			return err
 		}
-		u.remote = addr
-		u.stats.IncrRxMessages()
-		u.r <- buf[:rlen]
+
+		p := Packet{Data: make([]byte, rlen), Addr: addr, Received: received}
+		copy(p.Data, buf[:rlen])
+
+		if u.opts.tap != nil {
+			u.opts.tap(Inbound, p)
+		}
+
+		if reason := u.reject(p); reason != "" {
+			u.rejected(p, reason)
+
+			continue
+		}
+
+		// The buffer has one spare byte: filling it means the datagram did not fit.
+		if rlen > u.opts.readBuffer {
+			p.Data = p.Data[:u.opts.readBuffer]
+			u.stats.RecordTruncated(p)
+			u.sendError(&ParseError{Err: ErrTruncated, Packet: p})
+
+			continue
+		}
+
+		d := datagram{Packet: p}
+		d.resp, d.err = message.ParseAt(p.Data, p.Received)
+
+		if d.err == nil && ids != nil && !ids.check(p, d.resp) {
+			u.rejected(p, RejectIDConflict)
+
+			continue
+		}
+
+		u.setRemote(addr)
+		u.stats.RecordRx(p, d.resp, d.err)
+
+		if !u.enqueue(d) {
+			u.log.Println("reader: closing")
+
+			return nil
+		}
 	}
+}
 
-	return nil
+// readFrom reads a datagram, with the time given by the transport if it has one.
+func (u *UDPServer) readFrom(buf []byte) (int, *net.UDPAddr, time.Time, error) {
+	if t, ok := u.conn.(TimedPacketTransport); ok {
+		return t.ReadFromAt(buf)
+	}
+
+	n, addr, err := u.conn.ReadFrom(buf)
+
+	return n, addr, time.Now(), err
+}
+
+// enqueue hands p to the consumer according to the backpressure policy.
+// It returns false when the server is shutting down.
+func (u *UDPServer) enqueue(p datagram) bool {
+	switch u.opts.backpressure {
+	case DropNewest:
+		select {
+		case u.packets <- p:
+		case <-u.ctx.Done():
+			return false
+		default:
+			u.stats.IncrDropped()
+		}
+
+		return true
+	case DropOldest:
+		for {
+			select {
+			case u.packets <- p:
+				return true
+			case <-u.ctx.Done():
+				return false
+			default:
+			}
+
+			select {
+			case <-u.packets:
+				u.stats.IncrDropped()
+			default:
+				if cap(u.packets) == 0 {
+					u.stats.IncrDropped()
+
+					return true
+				}
+			}
+		}
+	default:
+		select {
+		case u.packets <- p:
+			return true
+		case <-u.ctx.Done():
+			return false
+		}
+	}
+}
+
+func (u *UDPServer) rawPump() {
+	for {
+		select {
+		case <-u.ctx.Done():
+			return
+		case p, ok := <-u.packets:
+			if !ok {
+				u.rClose.Do(func() { close(u.r) })
+
+				return
+			}
+
+			select {
+			case u.r <- p.Data:
+			case <-u.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (u *UDPServer) parsePump() {
+	for {
+		select {
+		case <-u.ctx.Done():
+			return
+		case p, ok := <-u.packets:
+			if !ok {
+				u.msgsClose.Do(func() { close(u.msgs) })
+
+				return
+			}
+
+			if p.err != nil {
+				u.sendError(&ParseError{Err: p.err, Packet: p.Packet})
+
+				continue
+			}
+
+			select {
+			case u.msgs <- Message{Response: p.resp, Addr: p.Addr, Received: p.Received}:
+			case <-u.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// sendError never blocks: errors are dropped when nobody reads Errors().
+func (u *UDPServer) sendError(err error) {
+	select {
+	case u.errs <- err:
+	default:
+		u.log.Println("error dropped:", err)
+	}
 }
 
 func (u *UDPServer) writer() {
 	defer u.wg.Done()
+
+	l := newLimiter(u.opts.rateLimits)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	<-timer.C
+
 	for {
+		var wake <-chan time.Time
+
+		if l != nil {
+			if d, ok := l.next(time.Now()); ok {
+				timer.Reset(d)
+				wake = timer.C
+			}
+		}
+
+		var ready []writeRequest
+
 		select {
 		case <-u.ctx.Done():
 			u.log.Println("writer: closing")
 
+			if l != nil {
+				l.drain(ErrClosed)
+			}
+
 			return
-		case w, ok := <-u.w:
-			if !ok {
-				u.cancel()
+		case w := <-u.w:
+			if l == nil {
+				w.result <- u.send(w.data, w.addr)
 
-				return
+				continue
+			}
+
+			ready = l.submit(w, time.Now())
+		case <-wake:
+			ready = l.ready(time.Now())
+		}
+
+		if wake != nil && !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
 			}
-			_, err := u.conn.WriteToUDP(w, u.remote)
-			if err != nil {
-				log.Println("Cannot write to Remote UDP Server:" + err.Error())
+		}
+
+		for _, w := range ready {
+			if err := w.ctx.Err(); err != nil {
+				w.result <- err
+
+				continue
 			}
-			u.stats.IncrTxMessages()
 
+			w.result <- u.send(w.data, w.addr)
 		}
 	}
 }
 
+func (u *UDPServer) send(data []byte, remote *net.UDPAddr) error {
+	if remote == nil {
+		remote = u.getRemote()
+	}
+
+	if remote == nil {
+		return ErrNoRemote
+	}
+
+	if _, err := u.conn.WriteTo(data, remote); err != nil {
+		u.log.Println("Cannot write to Remote UDP Server:", err)
+
+		return err
+	}
+
+	u.stats.RecordTx(len(data))
+
+	if u.opts.tap != nil {
+		u.opts.tap(Outbound, Packet{Data: data, Addr: remote, Received: time.Now()})
+	}
+
+	return nil
+}
+
+func (u *UDPServer) setRemote(addr *net.UDPAddr) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.remote = addr
+}
+
+func (u *UDPServer) getRemote() *net.UDPAddr {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	return u.remote
+}
+
+// Read returns the raw datagrams received from WSJT-X.
+// Read and Messages share the same stream: each datagram is delivered to only one of them.
 func (u *UDPServer) Read() chan []byte {
+	u.rawOnce.Do(func() {
+		u.spawn(u.rawPump)
+	})
+
 	return u.r
 }
 
-func (u *UDPServer) Write(w []byte) {
-	u.w <- w
+// Messages returns the parsed datagrams received from WSJT-X. Datagrams that
+// cannot be parsed are reported on Errors as *ParseError.
+func (u *UDPServer) Messages() <-chan Message {
+	u.msgOnce.Do(func() {
+		u.spawn(u.parsePump)
+	})
+
+	return u.msgs
+}
+
+// Errors returns parse errors of the datagrams consumed by Messages.
+// The channel is buffered and errors are dropped when it is full.
+func (u *UDPServer) Errors() <-chan error {
+	return u.errs
+}
+
+// Write sends msg to the last WSJT-X instance heard. It returns ErrClosed
+// when the server is closed, ErrNoRemote when nothing has been received yet,
+// ctx.Err() when ctx is done first, or the error of the socket.
+func (u *UDPServer) Write(ctx context.Context, msg []byte) error {
+	return u.WriteTo(ctx, msg, nil)
+}
+
+// WriteTo sends msg to addr, or to the last WSJT-X instance heard when addr is nil.
+func (u *UDPServer) WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error {
+	w := writeRequest{ctx: ctx, data: msg, addr: addr, result: make(chan error, 1)}
+
+	select {
+	case u.w <- w:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-u.ctx.Done():
+		return ErrClosed
+	}
+
+	select {
+	case err := <-w.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ResetStatus clears the counters returned by GetStatus.
+func (u *UDPServer) ResetStatus() {
+	u.stats.Reset()
 }
 
 func (u *UDPServer) GetStatus() Status {
-	return u.stats.GetStats()
+	s := u.stats.GetStats()
+	s.Buffered = len(u.packets)
+
+	return s
+}
+
+// LocalAddr returns the address the server is listening on.
+func (u *UDPServer) LocalAddr() net.Addr {
+	return u.conn.LocalAddr()
 }
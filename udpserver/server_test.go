@@ -0,0 +1,324 @@
+package udpserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+const testDecode = `adbccbda00000002000000020000000657534a542d5801021b3ee0fffffff1bfb99999a000000000000581000000017e000000105858585858205959595959204c4f31310000`
+
+var testLogger = log.New(io.Discard, "", 0)
+
+func hexToBytes(msg string) []byte {
+	b, _ := hex.DecodeString(msg)
+	return b
+}
+
+func newTestServer(t *testing.T) (*UDPServer, *net.UDPConn) {
+	t.Helper()
+
+	server, err := NewServer(context.Background(), Localhost, 0, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		client.Close()
+		server.Close()
+	})
+
+	return server, client
+}
+
+func TestUDPServer_Messages(t *testing.T) {
+	server, client := newTestServer(t)
+
+	if _, err := client.Write(hexToBytes(testDecode)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Write([]byte{0xde, 0xad, 0xbe, 0xef}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case m := <-server.Messages():
+		if m.ResponseType != message.DecodeType {
+			t.Errorf("Messages() type = %s, want %s", m.ResponseType, message.DecodeType)
+		}
+
+		if m.Addr.String() != client.LocalAddr().String() {
+			t.Errorf("Messages() addr = %s, want %s", m.Addr, client.LocalAddr())
+		}
+
+		d := m.Message.(message.DecodeResponse)
+		if !d.FullTime.Equal(m.Received.UTC().Truncate(24 * time.Hour).Add(35340 * time.Second)) {
+			t.Errorf("Messages() full time = %s, received %s", d.FullTime, m.Received)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Messages() timeout")
+	}
+
+	select {
+	case err := <-server.Errors():
+		var pErr *ParseError
+		if !errors.As(err, &pErr) || !errors.Is(err, message.ErrInvalidMagic) {
+			t.Errorf("Errors() = %v, want ParseError wrapping %v", err, message.ErrInvalidMagic)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Errors() timeout")
+	}
+}
+
+func TestUDPServer_Read(t *testing.T) {
+	server, client := newTestServer(t)
+
+	want := hexToBytes(testDecode)
+	if _, err := client.Write(want); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case got := <-server.Read():
+		if string(got) != string(want) {
+			t.Errorf("Read() = %x, want %x", got, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Read() timeout")
+	}
+}
+
+func TestUDPServer_Backpressure(t *testing.T) {
+	tests := []struct {
+		name         string
+		backpressure Backpressure
+		want         []byte
+	}{
+		{name: "drop newest", backpressure: DropNewest, want: []byte{0, 1}},
+		{name: "drop oldest", backpressure: DropOldest, want: []byte{3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := NewServer(context.Background(), Localhost, 0, testLogger, WithBuffer(2), WithBackpressure(tt.backpressure))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer server.Close()
+
+			client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+
+			for i := 0; i < 5; i++ {
+				if _, err := client.Write([]byte{byte(i)}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			// RecordRx runs before enqueue counts the drop: wait for Dropped itself.
+			deadline := time.Now().Add(time.Second)
+			for server.GetStatus().Dropped < 3 && time.Now().Before(deadline) {
+				time.Sleep(time.Millisecond)
+			}
+
+			if got := server.GetStatus().Dropped; got != 3 {
+				t.Errorf("Dropped = %d, want 3", got)
+			}
+
+			for _, want := range tt.want {
+				if got := <-server.Read(); got[0] != want {
+					t.Errorf("Read() = %d, want %d", got[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestUDPServer_CloseWhileBlocked(t *testing.T) {
+	server, err := NewServer(context.Background(), Localhost, 0, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err := client.Write([]byte{0}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for server.GetStatus().RxMessages < 1 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		server.Close()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Close() blocked by a pending datagram")
+	}
+}
+
+// loggedADIF builds a LoggedADIF datagram carrying the given ADIF record.
+func loggedADIF(adif string) []byte {
+	buf := make([]byte, 0, 22+len(adif))
+	for _, u := range []uint32{0xadbccbda, 2, 12, 6} {
+		buf = append(buf, byte(u>>24), byte(u>>16), byte(u>>8), byte(u))
+	}
+	buf = append(buf, "WSJT-X"...)
+
+	l := uint32(len(adif))
+	buf = append(buf, byte(l>>24), byte(l>>16), byte(l>>8), byte(l))
+
+	return append(buf, adif...)
+}
+
+func TestUDPServer_OversizedDatagram(t *testing.T) {
+	server, client := newTestServer(t)
+
+	adif := "<call:5>YYYYY <comment:4000>" + strings.Repeat("x", 4000) + " <EOR>"
+	if _, err := client.Write(loggedADIF(adif)); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case m := <-server.Messages():
+		l, ok := m.Message.(message.LoggedADIFResponse)
+		if !ok || l.ADIF != adif {
+			t.Errorf("Messages() = %+v, want LoggedADIF of %d bytes", m.Response, len(adif))
+		}
+	case err := <-server.Errors():
+		t.Fatalf("Errors() = %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("Messages() timeout")
+	}
+
+	if got := server.GetStatus().Truncated; got != 0 {
+		t.Errorf("Truncated = %d, want 0", got)
+	}
+}
+
+func TestUDPServer_TruncatedDatagram(t *testing.T) {
+	server, err := NewServer(context.Background(), Localhost, 0, testLogger, WithReadBuffer(1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	client, err := net.DialUDP("udp", nil, server.LocalAddr().(*net.UDPAddr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	if _, err := client.Write(loggedADIF(strings.Repeat("x", 2000))); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-server.Errors():
+		var pErr *ParseError
+		if !errors.Is(err, ErrTruncated) || !errors.As(err, &pErr) || len(pErr.Packet.Data) != 1024 {
+			t.Errorf("Errors() = %v, want %v with 1024 bytes", err, ErrTruncated)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Errors() timeout")
+	}
+
+	if got := server.GetStatus().Truncated; got != 1 {
+		t.Errorf("Truncated = %d, want 1", got)
+	}
+}
+
+func TestUDPServer_Write(t *testing.T) {
+	server, client := newTestServer(t)
+	ctx := context.Background()
+
+	if err := server.Write(ctx, []byte{1}); !errors.Is(err, ErrNoRemote) {
+		t.Errorf("Write() before any datagram = %v, want %v", err, ErrNoRemote)
+	}
+
+	if _, err := client.Write(hexToBytes(testDecode)); err != nil {
+		t.Fatal(err)
+	}
+	<-server.Read()
+
+	want := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: message.HaltTxImmediately})
+	if err := server.Write(ctx, want); err != nil {
+		t.Fatalf("Write() = %v", err)
+	}
+
+	buf := make([]byte, MaxDatagramSize)
+	_ = client.SetReadDeadline(time.Now().Add(time.Second))
+
+	n, err := client.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(buf[:n]) != string(want) {
+		t.Errorf("client got %x, want %x", buf[:n], want)
+	}
+
+	if got := server.GetStatus().TxMessages; got != 1 {
+		t.Errorf("TxMessages = %d, want 1", got)
+	}
+}
+
+func TestUDPServer_WriteAfterClose(t *testing.T) {
+	server, err := NewServer(context.Background(), Localhost, 0, testLogger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = server.Write(context.Background(), []byte{1})
+		}()
+	}
+
+	server.Close()
+	server.Close()
+	wg.Wait()
+
+	if err := server.Write(context.Background(), []byte{1}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Write() after Close = %v, want %v", err, ErrClosed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := server.Write(ctx, []byte{1}); err == nil {
+		t.Error("Write() with a done context succeeded")
+	}
+}
@@ -0,0 +1,286 @@
+package udpserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+)
+
+// Parse error categories used as keys of Status.ParseErrors.
+const (
+	ParseErrorTooShort      = "too_short"
+	ParseErrorInvalidMagic  = "invalid_magic"
+	ParseErrorUnknownSchema = "unknown_schema"
+	ParseErrorDateTime      = "date_time"
+	ParseErrorOther         = "other"
+)
+
+type stats struct {
+	rxMessages  int64
+	txMessages  int64
+	rxBytes     int64
+	txBytes     int64
+	dropped     int64
+	truncated   int64
+	lastRx      time.Time
+	lastTx      time.Time
+	byType      map[string]int64
+	parseErrors map[string]int64
+	rejected    map[string]int64
+	instances   map[string]*InstanceStatus
+	remotes     map[string]*RemoteStatus
+	decodes     map[BandMode]int64
+	started     time.Time
+	since       time.Time
+	sync.RWMutex
+}
+
+type Status struct {
+	RxMessages int64
+	TxMessages int64
+	RxBytes    int64
+	TxBytes    int64
+	Dropped    int64
+	Truncated  int64
+	Buffered   int
+	LastRx     time.Time
+	LastTx     time.Time
+	// MessagesByType counts the parsed messages by message.Response type.
+	MessagesByType map[string]int64
+	// ParseErrors counts the datagrams that cannot be parsed by ParseError* category.
+	ParseErrors map[string]int64
+	// Rejected counts the datagrams rejected by the Reject* reason.
+	Rejected map[string]int64
+	// Instances is keyed by WSJT-X instance ID.
+	Instances map[string]InstanceStatus
+	// Remotes is keyed by source address.
+	Remotes map[string]RemoteStatus
+	// Decodes counts the decodes by band and mode of the instance that made them.
+	Decodes map[BandMode]int64
+	Started time.Time
+	// Since is the time of the last ResetStatus, or Started.
+	Since  time.Time
+	Uptime time.Duration
+}
+
+// InstanceStatus holds the counters of a WSJT-X instance.
+type InstanceStatus struct {
+	Messages int64
+	Decodes  int64
+	Addr     string
+	Dial     uint64
+	Mode     string
+	LastSeen time.Time
+}
+
+// RemoteStatus holds the counters of a source address.
+type RemoteStatus struct {
+	Messages int64
+	Bytes    int64
+	LastSeen time.Time
+}
+
+// BandMode is a band and mode pair, like 20m FT8.
+type BandMode struct {
+	Band string
+	Mode string
+}
+
+func (b BandMode) String() string {
+	return b.Band + " " + b.Mode
+}
+
+// MarshalText lets Status.Decodes be encoded as a JSON object.
+func (b BandMode) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+func newStats() *stats {
+	now := time.Now()
+	s := &stats{started: now}
+	s.reset(now)
+
+	return s
+}
+
+func (s *stats) reset(now time.Time) {
+	s.rxMessages = 0
+	s.txMessages = 0
+	s.rxBytes = 0
+	s.txBytes = 0
+	s.dropped = 0
+	s.truncated = 0
+	s.lastRx = time.Time{}
+	s.lastTx = time.Time{}
+	s.byType = make(map[string]int64)
+	s.parseErrors = make(map[string]int64)
+	s.rejected = make(map[string]int64)
+	s.instances = make(map[string]*InstanceStatus)
+	s.remotes = make(map[string]*RemoteStatus)
+	s.decodes = make(map[BandMode]int64)
+	s.since = now
+}
+
+func (s *stats) Reset() {
+	s.Lock()
+	defer s.Unlock()
+	s.reset(time.Now())
+}
+
+// RecordRx counts a received datagram and the result of its parsing.
+func (s *stats) RecordRx(p Packet, resp message.Response, err error) {
+	s.Lock()
+	defer s.Unlock()
+	s.recordPacket(p)
+
+	if err != nil {
+		s.parseErrors[parseErrorCategory(err)]++
+
+		return
+	}
+
+	s.byType[resp.ResponseType]++
+
+	id := ResponseID(resp)
+	if id == "" {
+		return
+	}
+
+	i, ok := s.instances[id]
+	if !ok {
+		i = &InstanceStatus{}
+		s.instances[id] = i
+	}
+
+	i.Messages++
+	i.Addr = p.Addr.String()
+	i.LastSeen = p.Received
+
+	switch m := resp.Message.(type) {
+	case message.StatusResponse:
+		i.Dial = m.Dial
+		i.Mode = m.Mode
+	case message.DecodeResponse:
+		i.Decodes++
+		s.decodes[BandMode{Band: band.FromFrequency(i.Dial), Mode: i.Mode}]++
+	case message.WSPRDecodeResponse:
+		i.Decodes++
+		s.decodes[BandMode{Band: band.FromFrequency(m.FrequencyHz), Mode: "WSPR"}]++
+	}
+}
+
+func (s *stats) RecordTruncated(p Packet) {
+	s.Lock()
+	defer s.Unlock()
+	s.recordPacket(p)
+	s.truncated++
+}
+
+// RecordRejected counts a datagram rejected for reason. It is left out of the
+// other counters and of Remotes, which spoofed sources would grow without limit.
+func (s *stats) RecordRejected(reason string) {
+	s.Lock()
+	defer s.Unlock()
+	s.rejected[reason]++
+}
+
+func (s *stats) recordPacket(p Packet) {
+	s.rxMessages++
+	s.rxBytes += int64(len(p.Data))
+	s.lastRx = p.Received
+
+	addr := p.Addr.String()
+
+	r, ok := s.remotes[addr]
+	if !ok {
+		r = &RemoteStatus{}
+		s.remotes[addr] = r
+	}
+
+	r.Messages++
+	r.Bytes += int64(len(p.Data))
+	r.LastSeen = p.Received
+}
+
+func (s *stats) RecordTx(size int) {
+	s.Lock()
+	defer s.Unlock()
+	s.txMessages++
+	s.txBytes += int64(size)
+	s.lastTx = time.Now()
+}
+
+func (s *stats) IncrDropped() {
+	s.Lock()
+	defer s.Unlock()
+	s.dropped++
+}
+
+func (s *stats) GetStats() Status {
+	s.RLock()
+	defer s.RUnlock()
+
+	st := Status{
+		RxMessages:     s.rxMessages,
+		TxMessages:     s.txMessages,
+		RxBytes:        s.rxBytes,
+		TxBytes:        s.txBytes,
+		Dropped:        s.dropped,
+		Truncated:      s.truncated,
+		LastRx:         s.lastRx,
+		LastTx:         s.lastTx,
+		MessagesByType: make(map[string]int64, len(s.byType)),
+		ParseErrors:    make(map[string]int64, len(s.parseErrors)),
+		Rejected:       make(map[string]int64, len(s.rejected)),
+		Instances:      make(map[string]InstanceStatus, len(s.instances)),
+		Remotes:        make(map[string]RemoteStatus, len(s.remotes)),
+		Decodes:        make(map[BandMode]int64, len(s.decodes)),
+		Uptime:         time.Since(s.started),
+		Started:        s.started,
+		Since:          s.since,
+	}
+
+	for k, v := range s.byType {
+		st.MessagesByType[k] = v
+	}
+
+	for k, v := range s.parseErrors {
+		st.ParseErrors[k] = v
+	}
+
+	for k, v := range s.rejected {
+		st.Rejected[k] = v
+	}
+
+	for k, v := range s.instances {
+		st.Instances[k] = *v
+	}
+
+	for k, v := range s.remotes {
+		st.Remotes[k] = *v
+	}
+
+	for k, v := range s.decodes {
+		st.Decodes[k] = v
+	}
+
+	return st
+}
+
+func parseErrorCategory(err error) string {
+	switch {
+	case errors.Is(err, message.ErrMsgTooShort), errors.Is(err, message.ErrMsgZeroSize):
+		return ParseErrorTooShort
+	case errors.Is(err, message.ErrInvalidMagic):
+		return ParseErrorInvalidMagic
+	case errors.Is(err, message.ErrUnknownSchema):
+		return ParseErrorUnknownSchema
+	case errors.Is(err, message.ErrDateTimeFormat):
+		return ParseErrorDateTime
+	default:
+		return ParseErrorOther
+	}
+}
@@ -0,0 +1,57 @@
+package udpserver
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+const testStatus = `adbccbda00000002000000010000000657534a542d580000000001142f2000000003465438000000055858585858000000022d3600000003465438000001000003750000037500000006495535504d50000000064a4e3533455200000004494f393100ffffffff0000ffffffffffffffff0000000744656661756c7400000025585858585820495535504d50204a4e35332020202020202020202020202020202020202020`
+
+func TestStats_RecordRx(t *testing.T) {
+	s := newStats()
+	addr := &net.UDPAddr{IP: net.ParseIP(Localhost), Port: 55000}
+	now := time.Now()
+
+	for _, data := range [][]byte{hexToBytes(testStatus), hexToBytes(testDecode), hexToBytes(testDecode), {0, 0, 0, 0}} {
+		p := Packet{Data: data, Addr: addr, Received: now}
+		resp, err := message.ParseAt(p.Data, p.Received)
+		s.RecordRx(p, resp, err)
+	}
+	s.RecordTx(10)
+
+	st := s.GetStats()
+	if st.RxMessages != 4 || st.TxMessages != 1 || st.TxBytes != 10 {
+		t.Errorf("GetStats() rx = %d, tx = %d, tx bytes = %d", st.RxMessages, st.TxMessages, st.TxBytes)
+	}
+
+	if st.MessagesByType[message.DecodeType] != 2 || st.MessagesByType[message.StatusType] != 1 {
+		t.Errorf("GetStats() by type = %v", st.MessagesByType)
+	}
+
+	if st.ParseErrors[ParseErrorInvalidMagic] != 1 {
+		t.Errorf("GetStats() parse errors = %v", st.ParseErrors)
+	}
+
+	i := st.Instances["WSJT-X"]
+	if i.Messages != 3 || i.Decodes != 2 || i.Dial != 18100000 || i.Addr != addr.String() || !i.LastSeen.Equal(now) {
+		t.Errorf("GetStats() instance = %+v", i)
+	}
+
+	if got := st.Decodes[BandMode{Band: "17m", Mode: "FT8"}]; got != 2 {
+		t.Errorf("GetStats() decodes = %v", st.Decodes)
+	}
+
+	if r := st.Remotes[addr.String()]; r.Messages != 4 {
+		t.Errorf("GetStats() remote = %+v", r)
+	}
+
+	s.Reset()
+
+	st = s.GetStats()
+	if st.RxMessages != 0 || len(st.Instances) != 0 || len(st.Decodes) != 0 || st.Since.Before(st.Started) {
+		t.Errorf("GetStats() after Reset = %+v", st)
+	}
+}
@@ -0,0 +1,159 @@
+package udpserver
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const pipeQueue = 64
+
+// PacketTransport carries datagrams between the server and WSJT-X.
+type PacketTransport interface {
+	// ReadFrom blocks until a datagram is received, copies it in p and returns
+	// its length, which is len(p) when the datagram does not fit.
+	ReadFrom(p []byte) (n int, addr *net.UDPAddr, err error)
+	WriteTo(p []byte, addr *net.UDPAddr) (n int, err error)
+	Close() error
+	LocalAddr() net.Addr
+}
+
+// TimedPacketTransport is a PacketTransport that knows when its datagrams were
+// received, like a capture being replayed.
+type TimedPacketTransport interface {
+	PacketTransport
+	ReadFromAt(p []byte) (n int, addr *net.UDPAddr, received time.Time, err error)
+}
+
+type udpTransport struct {
+	*net.UDPConn
+}
+
+// ListenUDP returns a PacketTransport listening on ip and port. The ip can be
+// IPv4 or IPv6, with a zone for link-local addresses ("ff02::101%eth0"), or
+// DualStack to listen on all the IPv4 and IPv6 addresses. Multicast groups
+// are joined on the interface of the zone, or on the system default one.
+func ListenUDP(ip string, port int) (PacketTransport, error) {
+	return ListenMulticastUDP(ip, port, "")
+}
+
+// ListenMulticastUDP is ListenUDP joining multicast groups on the named interface.
+func ListenMulticastUDP(ip string, port int, ifname string) (PacketTransport, error) {
+	network := udpNetwork(ip)
+
+	addr, err := net.ResolveUDPAddr(network, net.JoinHostPort(ip, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.IP.IsMulticast() {
+		conn, err := net.ListenUDP(network, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		return udpTransport{conn}, nil
+	}
+
+	if ifname == "" {
+		ifname = addr.Zone
+	}
+
+	var ifi *net.Interface
+	if ifname != "" {
+		if ifi, err = net.InterfaceByName(ifname); err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := net.ListenMulticastUDP(network, ifi, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return udpTransport{conn}, nil
+}
+
+// udpNetwork returns udp4 or udp6 for an address of that family, udp for DualStack.
+func udpNetwork(ip string) string {
+	parsed := net.ParseIP(strings.SplitN(ip, "%", 2)[0])
+
+	switch {
+	case parsed == nil || parsed.Equal(net.IPv6unspecified):
+		return "udp"
+	case parsed.To4() != nil:
+		return "udp4"
+	default:
+		return "udp6"
+	}
+}
+
+func (t udpTransport) ReadFrom(p []byte) (int, *net.UDPAddr, error) {
+	return t.ReadFromUDP(p)
+}
+
+func (t udpTransport) WriteTo(p []byte, addr *net.UDPAddr) (int, error) {
+	return t.WriteToUDP(p, addr)
+}
+
+// pipeEnd is one end of an in-memory pipe.
+type pipeEnd struct {
+	addr      *net.UDPAddr
+	in        chan []byte
+	peer      *pipeEnd
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewPipe returns two connected in-memory transports, with the addresses a and b.
+// Like UDP, datagrams are dropped when the queue of the receiving end is full.
+func NewPipe(a, b *net.UDPAddr) (PacketTransport, PacketTransport) {
+	ea := &pipeEnd{addr: a, in: make(chan []byte, pipeQueue), done: make(chan struct{})}
+	eb := &pipeEnd{addr: b, in: make(chan []byte, pipeQueue), done: make(chan struct{})}
+	ea.peer, eb.peer = eb, ea
+
+	return ea, eb
+}
+
+func (e *pipeEnd) ReadFrom(p []byte) (int, *net.UDPAddr, error) {
+	select {
+	case <-e.done:
+		return 0, nil, net.ErrClosed
+	case d := <-e.in:
+		return copy(p, d), e.peer.addr, nil
+	}
+}
+
+// WriteTo delivers p to the other end whatever addr is.
+func (e *pipeEnd) WriteTo(p []byte, _ *net.UDPAddr) (int, error) {
+	select {
+	case <-e.done:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	d := make([]byte, len(p))
+	copy(d, p)
+
+	select {
+	case e.peer.in <- d:
+	case <-e.peer.done:
+	default:
+	}
+
+	return len(p), nil
+}
+
+func (e *pipeEnd) Close() error {
+	e.closeOnce.Do(func() {
+		close(e.done)
+	})
+
+	return nil
+}
+
+func (e *pipeEnd) LocalAddr() net.Addr {
+	return e.addr
+}
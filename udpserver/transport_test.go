@@ -0,0 +1,96 @@
+package udpserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestNewServerWithTransport_Pipe(t *testing.T) {
+	serverAddr := &net.UDPAddr{IP: net.ParseIP(Localhost), Port: DefaultPort}
+	wsjtxAddr := &net.UDPAddr{IP: net.ParseIP(Localhost), Port: 55000}
+	serverEnd, wsjtx := NewPipe(serverAddr, wsjtxAddr)
+	defer wsjtx.Close()
+
+	server := NewServerWithTransport(context.Background(), serverEnd, testLogger)
+	defer server.Close()
+
+	if _, err := wsjtx.WriteTo(hexToBytes(testDecode), serverAddr); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case m := <-server.Messages():
+		if m.ResponseType != message.DecodeType || m.Addr.String() != wsjtxAddr.String() {
+			t.Errorf("Messages() = %s from %s", m.ResponseType, m.Addr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Messages() timeout")
+	}
+
+	want := message.EncodeReplay(message.ReplayMessage{ID: "WSJT-X"})
+	if err := server.Write(context.Background(), want); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, MaxDatagramSize)
+
+	n, addr, err := wsjtx.ReadFrom(buf)
+	if err != nil || string(buf[:n]) != string(want) || addr.String() != serverAddr.String() {
+		t.Errorf("ReadFrom() = %x from %s, %v, want %x", buf[:n], addr, err, want)
+	}
+}
+
+// finiteTransport returns its datagrams, then io.EOF.
+type finiteTransport struct {
+	mu    sync.Mutex
+	queue [][]byte
+}
+
+func (f *finiteTransport) ReadFrom(p []byte) (int, *net.UDPAddr, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.queue) == 0 {
+		return 0, nil, io.EOF
+	}
+
+	n := copy(p, f.queue[0])
+	f.queue = f.queue[1:]
+
+	return n, &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2237}, nil
+}
+
+func (f *finiteTransport) WriteTo(p []byte, _ *net.UDPAddr) (int, error) { return len(p), nil }
+func (f *finiteTransport) Close() error                                  { return nil }
+func (f *finiteTransport) LocalAddr() net.Addr                           { return &net.UDPAddr{} }
+
+func TestNewServerWithTransport_EndOfInput(t *testing.T) {
+	transport := &finiteTransport{}
+	for i := 0; i < 5; i++ {
+		transport.queue = append(transport.queue, hexToBytes(testDecode))
+	}
+
+	server := NewServerWithTransport(context.Background(), transport, testLogger, WithBuffer(8))
+	defer server.Close()
+
+	// The whole input is queued before Messages starts consuming it.
+	deadline := time.Now().Add(time.Second)
+	for server.GetStatus().RxMessages < 5 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	n := 0
+	for range server.Messages() {
+		n++
+	}
+
+	if n != 5 {
+		t.Errorf("Messages() delivered %d messages, want 5", n)
+	}
+}
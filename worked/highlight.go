@@ -0,0 +1,344 @@
+package worked
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/logocomune/wsjtx/adif"
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/msgtext"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	// DefaultExpiry is how long a callsign stays highlighted after its last decode.
+	DefaultExpiry = 10 * time.Minute
+
+	writeTimeout = 5 * time.Second
+	queueSize    = 64
+)
+
+// Colors are the colors of a highlighted callsign. The zero Colors, made of
+// invalid colors, highlight nothing.
+type Colors struct {
+	Background message.QColor
+	Foreground message.QColor
+}
+
+// DefaultColors are the colors of each class; NewCall and Worked are not highlighted.
+func DefaultColors() map[Class]Colors {
+	return map[Class]Colors{
+		NewDXCC: {Background: message.RGB(0xff00ff), Foreground: message.RGB(0x000000)},
+		NewGrid: {Background: message.RGB(0xff8000), Foreground: message.RGB(0x000000)},
+		NewBand: {Background: message.RGB(0xffff00), Foreground: message.RGB(0x000000)},
+		NewMode: {Background: message.RGB(0x80c0ff), Foreground: message.RGB(0x000000)},
+		Dupe:    {Foreground: message.RGB(0x808080)},
+	}
+}
+
+// Writer sends datagrams. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+type highlightKey struct {
+	instance string
+	call     string
+}
+
+type highlight struct {
+	class Class
+	grid  string
+	addr  *net.UDPAddr
+	seen  time.Time
+}
+
+type station struct {
+	band string
+	mode string
+}
+
+// Highlighter classifies the decoded callsigns with a Store and highlights
+// them in WSJT-X with the colors of their class. A highlight is cleared when
+// it no longer applies: the class changes to one without colors, the band or
+// mode changes, or the callsign is not decoded for the expiry time.
+type Highlighter struct {
+	store    *Store
+	w        Writer
+	log      logger
+	colors   map[Class]Colors
+	last     bool
+	expiry   time.Duration
+	now      func() time.Time
+	queue    chan udpserver.Packet
+	mu       sync.Mutex
+	stations map[string]station
+	current  map[highlightKey]*highlight
+}
+
+// HighlightOption configures a Highlighter.
+type HighlightOption func(*Highlighter)
+
+// WithColors sets the colors of class; the zero Colors disables its highlighting.
+func WithColors(class Class, c Colors) HighlightOption {
+	return func(h *Highlighter) {
+		h.colors[class] = c
+	}
+}
+
+// WithHighlightLast highlights only the last decode of a callsign instead of all of them.
+func WithHighlightLast(last bool) HighlightOption {
+	return func(h *Highlighter) {
+		h.last = last
+	}
+}
+
+// WithExpiry sets how long a callsign stays highlighted after its last decode.
+func WithExpiry(d time.Duration) HighlightOption {
+	return func(h *Highlighter) {
+		if d > 0 {
+			h.expiry = d
+		}
+	}
+}
+
+func NewHighlighter(store *Store, w Writer, logger logger, opts ...HighlightOption) *Highlighter {
+	h := &Highlighter{
+		store:    store,
+		w:        w,
+		log:      logger,
+		colors:   DefaultColors(),
+		expiry:   DefaultExpiry,
+		now:      time.Now,
+		queue:    make(chan udpserver.Packet, queueSize),
+		stations: make(map[string]station),
+		current:  make(map[highlightKey]*highlight),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Register feeds the highlighter, and its store, with the messages
+// dispatched by router. Do not also register the store.
+func (h *Highlighter) Register(router *udpserver.Router) {
+	router.OnStatus(func(m udpserver.Message, s message.StatusResponse) {
+		h.Status(m.Addr, s)
+	})
+	router.OnDecode(func(m udpserver.Message, d message.DecodeResponse) {
+		h.Decode(m.Addr, d)
+	})
+	router.OnQSOLogged(func(m udpserver.Message, q message.QSOLoggedResponse) {
+		h.store.Add(ContactFromQSOLogged(q))
+		h.Refresh()
+	})
+	router.OnLoggedADIF(func(m udpserver.Message, l message.LoggedADIFResponse) {
+		records, err := adif.Parse(l.ADIF)
+		if err != nil {
+			h.log.Println("worked: logged ADIF:", err)
+
+			return
+		}
+
+		for _, r := range records {
+			h.store.Add(ContactFromADIF(r))
+		}
+
+		h.Refresh()
+	})
+	router.OnClose(func(m udpserver.Message, c message.CloseResponse) {
+		h.Forget(c.ID)
+	})
+}
+
+// Run sends the highlights and clears the expired ones until ctx is done.
+func (h *Highlighter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case p := <-h.queue:
+			h.write(ctx, p)
+		case <-ticker.C:
+			h.Expire()
+		}
+	}
+}
+
+// Status records the band and mode of the instance, refreshing its highlights when they change.
+func (h *Highlighter) Status(addr *net.UDPAddr, s message.StatusResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st := station{band: band.FromFrequency(s.Dial), mode: s.Mode}
+	if old, ok := h.stations[s.ID]; ok && old == st {
+		return
+	}
+
+	h.stations[s.ID] = st
+
+	for k, hl := range h.current {
+		if k.instance == s.ID {
+			hl.addr = addr
+			h.update(k, hl, h.store.Classify(k.call, hl.grid, st.band, st.mode))
+		}
+	}
+}
+
+// Decode classifies the stations sending the decoded message and highlights them.
+func (h *Highlighter) Decode(addr *net.UDPAddr, d message.DecodeResponse) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st, ok := h.stations[d.ID]
+	if !ok || st.band == "" {
+		return
+	}
+
+	now := h.now()
+
+	for _, m := range msgtext.ParseAll(d.Message) {
+		if m.From == "" {
+			continue
+		}
+
+		k := highlightKey{instance: d.ID, call: m.From}
+
+		hl, ok := h.current[k]
+		if !ok {
+			hl = &highlight{class: -1}
+		}
+
+		hl.addr, hl.seen = addr, now
+		if m.Grid != "" {
+			hl.grid = m.Grid
+		}
+
+		h.update(k, hl, h.store.Classify(m.From, hl.grid, st.band, st.mode))
+	}
+}
+
+// Refresh classifies again the highlighted callsigns, after contacts were added to the store.
+func (h *Highlighter) Refresh() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for k, hl := range h.current {
+		st := h.stations[k.instance]
+		h.update(k, hl, h.store.Classify(k.call, hl.grid, st.band, st.mode))
+	}
+}
+
+// Expire clears the highlights of the callsigns not decoded for the expiry time.
+func (h *Highlighter) Expire() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+
+	for k, hl := range h.current {
+		if now.Sub(hl.seen) >= h.expiry {
+			h.update(k, hl, -1)
+		}
+	}
+}
+
+// Forget drops the highlights of an instance without clearing them, as when it closes.
+func (h *Highlighter) Forget(id string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.stations, id)
+
+	for k := range h.current {
+		if k.instance == id {
+			delete(h.current, k)
+		}
+	}
+}
+
+// Highlighted returns the class of the callsigns highlighted on an instance.
+func (h *Highlighter) Highlighted(id string) map[string]Class {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	out := make(map[string]Class)
+
+	for k, hl := range h.current {
+		if k.instance == id {
+			out[k.call] = hl.class
+		}
+	}
+
+	return out
+}
+
+// update sends the highlight of class, or clears it when class has no
+// colors; class -1 clears it too. A highlight whose datagram is dropped is
+// sent again by the next update, as the key is only forgotten once its clear
+// is queued.
+func (h *Highlighter) update(k highlightKey, hl *highlight, class Class) {
+	colors, ok := h.colors[class]
+	if class < 0 || !ok {
+		colors = Colors{}
+	}
+
+	if colors == (Colors{}) {
+		if _, highlighted := h.current[k]; highlighted && h.send(k, hl.addr, Colors{}) {
+			delete(h.current, k)
+		}
+
+		return
+	}
+
+	h.current[k] = hl
+	if hl.class == class {
+		return
+	}
+
+	if h.send(k, hl.addr, colors) {
+		hl.class = class
+	}
+}
+
+// send queues a highlight for Run, reporting whether it was queued.
+func (h *Highlighter) send(k highlightKey, addr *net.UDPAddr, c Colors) bool {
+	data := message.EncodeHighlightCallsign(message.HighlightCallsignMessage{
+		ID:              k.instance,
+		Callsign:        k.call,
+		BackgroundColor: c.Background,
+		ForegroundColor: c.Foreground,
+		HighlightLast:   h.last,
+	})
+
+	select {
+	case h.queue <- udpserver.Packet{Data: data, Addr: addr}:
+		return true
+	default:
+		h.log.Println("worked: highlight dropped:", k.call)
+
+		return false
+	}
+}
+
+func (h *Highlighter) write(ctx context.Context, p udpserver.Packet) {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+
+	if err := h.w.WriteTo(ctx, p.Data, p.Addr); err != nil {
+		h.log.Println("worked: highlight:", err)
+	}
+}
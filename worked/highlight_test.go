@@ -0,0 +1,121 @@
+package worked
+
+import (
+	"io"
+	"log"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+func sent(t *testing.T, h *Highlighter) map[string]Colors {
+	t.Helper()
+
+	out := make(map[string]Colors)
+
+	for {
+		select {
+		case p := <-h.queue:
+			hl, err := message.ParseHighlightCallsign(p.Data)
+			if err != nil {
+				t.Fatalf("ParseHighlightCallsign() error = %v", err)
+			}
+
+			out[hl.Callsign] = Colors{Background: hl.BackgroundColor, Foreground: hl.ForegroundColor}
+		default:
+			return out
+		}
+	}
+}
+
+func TestHighlighter(t *testing.T) {
+	store := NewStore()
+	store.Add(Contact{Call: "K1ABC", Grid: "FN42", Band: "20m", Mode: "FT8"})
+
+	now := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+	addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2237}
+
+	h := NewHighlighter(store, nil, log.New(io.Discard, "", 0), WithColors(Dupe, Colors{}), WithExpiry(time.Minute))
+	h.now = func() time.Time { return now }
+	colors := DefaultColors()
+
+	h.Decode(addr, message.DecodeResponse{ID: "WSJT-X", Message: "CQ JA1XYZ PM95"})
+	if got := sent(t, h); len(got) != 0 {
+		t.Errorf("before Status sent %v, want nothing", got)
+	}
+
+	h.Status(addr, message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8"})
+
+	for _, text := range []string{"CQ JA1XYZ PM95", "CQ K1ABC FN42", "W9XYZ K1ABC -10", "CQ W9XYZ EN37", "JA1XYZ W9XYZ R-05"} {
+		h.Decode(addr, message.DecodeResponse{ID: "WSJT-X", Message: text})
+	}
+
+	want := map[string]Colors{"JA1XYZ": colors[NewDXCC], "W9XYZ": colors[NewGrid]}
+	if got := sent(t, h); !reflect.DeepEqual(got, want) {
+		t.Errorf("sent %v, want %v", got, want)
+	}
+
+	h.store.Add(Contact{Call: "W9XYZ", Grid: "EN37", Band: "20m", Mode: "FT8"})
+	h.Refresh()
+
+	if got := sent(t, h); !reflect.DeepEqual(got, map[string]Colors{"W9XYZ": {}}) {
+		t.Errorf("after logging W9XYZ sent %v, want it cleared", got)
+	}
+
+	h.Status(addr, message.StatusResponse{ID: "WSJT-X", Dial: 7074000, Mode: "FT8"})
+
+	if got := h.Highlighted("WSJT-X"); !reflect.DeepEqual(got, map[string]Class{"JA1XYZ": NewDXCC}) {
+		t.Errorf("Highlighted() = %v", got)
+	}
+
+	if got := sent(t, h); len(got) != 0 {
+		t.Errorf("band change sent %v, want nothing as JA1XYZ stays a new DXCC", got)
+	}
+
+	now = now.Add(time.Minute)
+	h.Expire()
+
+	if got := sent(t, h); !reflect.DeepEqual(got, map[string]Colors{"JA1XYZ": {}}) {
+		t.Errorf("expiry sent %v, want JA1XYZ cleared", got)
+	}
+}
+
+func TestHighlighterQueueFull(t *testing.T) {
+	now := time.Date(2022, 2, 4, 10, 40, 0, 0, time.UTC)
+	addr := &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 2237}
+
+	h := NewHighlighter(NewStore(), nil, log.New(io.Discard, "", 0), WithExpiry(time.Minute))
+	h.now = func() time.Time { return now }
+	h.Status(addr, message.StatusResponse{ID: "WSJT-X", Dial: 14074000, Mode: "FT8"})
+	h.Decode(addr, message.DecodeResponse{ID: "WSJT-X", Message: "CQ JA1XYZ PM95"})
+	sent(t, h)
+
+	for len(h.queue) < cap(h.queue) {
+		h.queue <- udpserver.Packet{}
+	}
+
+	now = now.Add(time.Minute)
+	h.Expire()
+
+	if got := h.Highlighted("WSJT-X"); len(got) != 1 {
+		t.Fatalf("Highlighted() = %v, want JA1XYZ kept until its clear is queued", got)
+	}
+
+	for len(h.queue) > 0 {
+		<-h.queue
+	}
+
+	h.Expire()
+
+	if got := sent(t, h); !reflect.DeepEqual(got, map[string]Colors{"JA1XYZ": {}}) {
+		t.Errorf("second expiry sent %v, want JA1XYZ cleared", got)
+	}
+
+	if got := h.Highlighted("WSJT-X"); len(got) != 0 {
+		t.Errorf("Highlighted() = %v, want none", got)
+	}
+}
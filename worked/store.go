@@ -0,0 +1,236 @@
+// Package worked keeps the stations worked before and highlights the decoded
+// callsigns in WSJT-X by how new they are.
+package worked
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/logocomune/wsjtx/adif"
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/dxcc"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+// Class tells how new a station is.
+type Class int
+
+const (
+	// NewDXCC is a station of an entity never worked.
+	NewDXCC Class = iota
+	// NewGrid is a station in a grid never worked.
+	NewGrid
+	// NewBand is a station of an entity never worked on the band.
+	NewBand
+	// NewMode is a station of an entity never worked in the mode.
+	NewMode
+	// NewCall is a station never worked, in an entity already worked on the band and mode.
+	NewCall
+	// Worked is a station already worked, on another band or mode.
+	Worked
+	// Dupe is a station already worked on the band and mode.
+	Dupe
+)
+
+var classNames = map[Class]string{
+	NewDXCC: "new_dxcc",
+	NewGrid: "new_grid",
+	NewBand: "new_band",
+	NewMode: "new_mode",
+	NewCall: "new_call",
+	Worked:  "worked",
+	Dupe:    "dupe",
+}
+
+func (c Class) String() string {
+	if n, ok := classNames[c]; ok {
+		return n
+	}
+
+	return "unknown"
+}
+
+// Contact is a logged QSO, reduced to what the classification needs.
+type Contact struct {
+	Call string
+	// Grid is the 4 character locator, empty when unknown.
+	Grid string
+	Band string
+	Mode string
+}
+
+type slot struct {
+	key  string
+	band string
+	mode string
+}
+
+// Store keeps the contacts worked before, by callsign, grid, DXCC entity, band and mode.
+type Store struct {
+	table *dxcc.Table
+	mu    sync.RWMutex
+	calls map[string]bool
+	grids map[string]bool
+	// slots has the calls and the entities worked on a band and mode;
+	// an empty band or mode matches any.
+	slots map[slot]bool
+}
+
+// StoreOption configures a Store.
+type StoreOption func(*Store)
+
+// WithTable sets the DXCC table used to find the entity of the callsigns, dxcc.Default() by default.
+func WithTable(t *dxcc.Table) StoreOption {
+	return func(s *Store) {
+		s.table = t
+	}
+}
+
+func NewStore(opts ...StoreOption) *Store {
+	s := &Store{
+		table: dxcc.Default(),
+		calls: make(map[string]bool),
+		grids: make(map[string]bool),
+		slots: make(map[slot]bool),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Register feeds the store with the QSOLogged and LoggedADIF messages dispatched by router.
+func (s *Store) Register(router *udpserver.Router) {
+	router.OnQSOLogged(func(m udpserver.Message, q message.QSOLoggedResponse) {
+		s.Add(ContactFromQSOLogged(q))
+	})
+	router.OnLoggedADIF(func(m udpserver.Message, l message.LoggedADIFResponse) {
+		records, err := adif.Parse(l.ADIF)
+		if err != nil {
+			return
+		}
+
+		for _, r := range records {
+			s.Add(ContactFromADIF(r))
+		}
+	})
+}
+
+// Import adds the contacts of an ADIF log file and returns how many were read.
+func (s *Store) Import(r io.Reader) (int, error) {
+	records, err := adif.Read(r)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+
+	for _, rec := range records {
+		if c := ContactFromADIF(rec); c.Call != "" {
+			s.Add(c)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// Add records a contact. Adding the same contact again has no effect.
+func (s *Store) Add(c Contact) {
+	c = normalize(c)
+	if c.Call == "" {
+		return
+	}
+
+	entity := s.entity(c.Call)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls[c.Call] = true
+	if c.Grid != "" {
+		s.grids[c.Grid] = true
+	}
+
+	for _, key := range []string{"=" + c.Call, entity} {
+		if key == "" {
+			continue
+		}
+
+		s.slots[slot{key: key}] = true
+		s.slots[slot{key: key, band: c.Band}] = true
+		s.slots[slot{key: key, mode: c.Mode}] = true
+		s.slots[slot{key: key, band: c.Band, mode: c.Mode}] = true
+	}
+}
+
+// Classify tells how new the station call is on band and mode. grid may be empty.
+func (s *Store) Classify(call, grid, band, mode string) Class {
+	c := normalize(Contact{Call: call, Grid: grid, Band: band, Mode: mode})
+	entity := s.entity(c.Call)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	switch {
+	case entity != "" && !s.slots[slot{key: entity}]:
+		return NewDXCC
+	case c.Grid != "" && !s.grids[c.Grid]:
+		return NewGrid
+	case entity != "" && !s.slots[slot{key: entity, band: c.Band}]:
+		return NewBand
+	case entity != "" && !s.slots[slot{key: entity, mode: c.Mode}]:
+		return NewMode
+	case s.slots[slot{key: "=" + c.Call, band: c.Band, mode: c.Mode}]:
+		return Dupe
+	case s.calls[c.Call]:
+		return Worked
+	default:
+		return NewCall
+	}
+}
+
+// Len returns the number of callsigns worked.
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.calls)
+}
+
+// entity returns the key of the DXCC entity of call, empty when unknown.
+func (s *Store) entity(call string) string {
+	if e, ok := s.table.Lookup(call); ok {
+		return e.Prefix
+	}
+
+	return ""
+}
+
+func normalize(c Contact) Contact {
+	c.Call = strings.ToUpper(strings.TrimSpace(c.Call))
+	c.Band = strings.ToLower(strings.TrimSpace(c.Band))
+	c.Mode = strings.ToUpper(strings.TrimSpace(c.Mode))
+
+	c.Grid = strings.ToUpper(strings.TrimSpace(c.Grid))
+	if len(c.Grid) > 4 {
+		c.Grid = c.Grid[:4]
+	}
+
+	return c
+}
+
+// ContactFromQSOLogged returns the contact of a QSOLogged message.
+func ContactFromQSOLogged(q message.QSOLoggedResponse) Contact {
+	return Contact{Call: q.DXCall, Grid: q.DXGrid, Band: band.FromFrequency(q.TXFrequencyHz), Mode: q.Mode}
+}
+
+// ContactFromADIF returns the contact of an ADIF record, with the band and
+// mode of Record.Band and Record.Mode.
+func ContactFromADIF(r adif.Record) Contact {
+	return Contact{Call: r.Get("CALL"), Grid: r.Get("GRIDSQUARE"), Band: r.Band(), Mode: r.Mode()}
+}
@@ -0,0 +1,70 @@
+package worked
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/logocomune/wsjtx/message"
+)
+
+func TestStore_Classify(t *testing.T) {
+	s := NewStore()
+	s.Add(Contact{Call: "K1ABC", Grid: "FN42", Band: "20m", Mode: "FT8"})
+	s.Add(ContactFromQSOLogged(message.QSOLoggedResponse{DXCall: "EA8XYZ", DXGrid: "IL18", TXFrequencyHz: 7075500, Mode: "FT8"}))
+
+	tests := []struct {
+		name                   string
+		call, grid, band, mode string
+		want                   Class
+	}{
+		{name: "dupe", call: "K1ABC", band: "20m", mode: "FT8", want: Dupe},
+		{name: "worked other band", call: "k1abc", band: "40m", mode: "FT8", want: NewBand},
+		{name: "new dxcc", call: "JA1XYZ", grid: "PM95", band: "20m", mode: "FT8", want: NewDXCC},
+		{name: "new grid", call: "W9XYZ", grid: "EN37", band: "20m", mode: "FT8", want: NewGrid},
+		{name: "new band", call: "W9XYZ", grid: "FN42", band: "40m", mode: "FT8", want: NewBand},
+		{name: "new mode", call: "W9XYZ", band: "20m", mode: "FT4", want: NewMode},
+		{name: "new call", call: "W9XYZ", grid: "FN42", band: "20m", mode: "FT8", want: NewCall},
+		{name: "worked", call: "EA8XYZ", band: "40m", mode: "FT8", want: Dupe},
+		{name: "worked other mode", call: "EA8XYZ", band: "40m", mode: "FT4", want: NewMode},
+		{name: "unknown entity", call: "QQ1ABC", band: "20m", mode: "FT8", want: NewCall},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Classify(tt.call, tt.grid, tt.band, tt.mode); got != tt.want {
+				t.Errorf("Classify() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStore_Worked(t *testing.T) {
+	s := NewStore()
+	s.Add(Contact{Call: "K1ABC", Band: "20m", Mode: "FT8"})
+	s.Add(Contact{Call: "W9XYZ", Band: "40m", Mode: "FT4"})
+
+	if got := s.Classify("K1ABC", "", "40m", "FT4"); got != Worked {
+		t.Errorf("Classify() = %s, want worked", got)
+	}
+}
+
+func TestStore_Import(t *testing.T) {
+	const log = `WSJT-X ADIF Export<eoh>
+<call:5>K1ABC <gridsquare:6>FN42ab <mode:4>MFSK <submode:3>FT4 <freq:9>14.080000 <eor>
+<call:6>EA8XYZ <band:3>40m <mode:3>FT8 <eor>
+<gridsquare:4>JN45 <eor>`
+
+	s := NewStore()
+
+	n, err := s.Import(strings.NewReader(log))
+	if err != nil || n != 2 {
+		t.Fatalf("Import() = %d, %v, want 2", n, err)
+	}
+
+	if got := s.Classify("K1ABC", "FN42", "20m", "FT4"); got != Dupe {
+		t.Errorf("Classify(K1ABC) = %s, want dupe", got)
+	}
+
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", s.Len())
+	}
+}
@@ -0,0 +1,345 @@
+// Package wsbridge streams the messages of a udpserver to WebSocket clients as
+// JSON and forwards the commands of authorized clients to WSJT-X.
+//
+// Each message is sent as the JSON of udpserver.Message with the band of the
+// instance added. Clients send JSON requests:
+//
+//	{"action": "filter", "filter": {"types": ["DECODE"], "band": "20m", "callsign": "K1*"}}
+//	{"action": "command", "id": "1", "command": "reply", "instance": "WSJT-X", "params": {...}}
+//
+// The command names and parameters are the ones of the command package.
+// Commands are answered with {"type": "result", "id": "1", "ok": true}.
+package wsbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/logocomune/wsjtx/band"
+	"github.com/logocomune/wsjtx/command"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+const (
+	clientQueue  = 256
+	writeTimeout = 5 * time.Second
+	pingInterval = 30 * time.Second
+)
+
+var (
+	ErrNotAuthorized   = errors.New("wsbridge: client not authorized to send commands")
+	ErrUnknownInstance = errors.New("wsbridge: unknown instance")
+	ErrUnknownAction   = errors.New("wsbridge: unknown action")
+)
+
+// Writer sends datagrams to an address. It is implemented by *udpserver.UDPServer.
+type Writer interface {
+	WriteTo(ctx context.Context, msg []byte, addr *net.UDPAddr) error
+}
+
+// Instances gives the address commands are sent to. It is implemented by *instance.Registry.
+type Instances interface {
+	Get(id string) (instance.Instance, bool)
+}
+
+type logger interface {
+	Println(v ...interface{})
+}
+
+// Bridge is an http.Handler upgrading requests to WebSocket connections.
+type Bridge struct {
+	w         Writer
+	instances Instances
+	log       logger
+	authorize func(*http.Request) bool
+	upgrader  websocket.Upgrader
+	mu        sync.RWMutex
+	clients   map[*client]struct{}
+	bands     map[string]string
+}
+
+// Option configures a Bridge.
+type Option func(*Bridge)
+
+// WithAuthorizer sets which clients may send commands. By default no client can.
+func WithAuthorizer(authorize func(*http.Request) bool) Option {
+	return func(b *Bridge) {
+		b.authorize = authorize
+	}
+}
+
+// WithCheckOrigin sets the origin check of the WebSocket handshake.
+// By default the origin must match the host.
+func WithCheckOrigin(check func(*http.Request) bool) Option {
+	return func(b *Bridge) {
+		b.upgrader.CheckOrigin = check
+	}
+}
+
+func NewBridge(w Writer, instances Instances, logger logger, opts ...Option) *Bridge {
+	b := &Bridge{
+		w:         w,
+		instances: instances,
+		log:       logger,
+		authorize: func(*http.Request) bool { return false },
+		clients:   make(map[*client]struct{}),
+		bands:     make(map[string]string),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Register publishes every message dispatched by router.
+func (b *Bridge) Register(router *udpserver.Router) {
+	router.HandleAll(b.Publish)
+}
+
+type outMessage struct {
+	udpserver.Message
+	Band string
+}
+
+func (o outMessage) MarshalJSON() ([]byte, error) {
+	raw, err := o.Message.MarshalJSON()
+	if err != nil || o.Band == "" {
+		return raw, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	fields["band"], _ = json.Marshal(o.Band)
+
+	return json.Marshal(fields)
+}
+
+// Publish sends m to the clients whose filter matches it.
+func (b *Bridge) Publish(m udpserver.Message) {
+	id := m.InstanceID()
+
+	b.mu.Lock()
+	if s, ok := m.Message.(message.StatusResponse); ok {
+		b.bands[id] = band.FromFrequency(s.Dial)
+	}
+
+	bnd := b.bands[id]
+	b.mu.Unlock()
+
+	var data []byte
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for c := range b.clients {
+		if !c.getFilter().Match(m, bnd) {
+			continue
+		}
+
+		if data == nil {
+			var err error
+			if data, err = json.Marshal(outMessage{Message: m, Band: bnd}); err != nil {
+				b.log.Println("wsbridge: cannot encode", m.ResponseType+":", err)
+
+				return
+			}
+		}
+
+		c.send(data)
+	}
+}
+
+// Close disconnects all the clients.
+func (b *Bridge) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for c := range b.clients {
+		c.close()
+		delete(b.clients, c)
+	}
+}
+
+func (b *Bridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := b.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		b.log.Println("wsbridge: upgrade:", err)
+
+		return
+	}
+
+	c := &client{
+		conn:       conn,
+		out:        make(chan []byte, clientQueue),
+		done:       make(chan struct{}),
+		filter:     filterFromQuery(r.URL.Query()),
+		authorized: b.authorize(r),
+	}
+
+	b.mu.Lock()
+	b.clients[c] = struct{}{}
+	b.mu.Unlock()
+
+	go c.writer(b.log)
+	b.reader(c)
+
+	b.mu.Lock()
+	delete(b.clients, c)
+	b.mu.Unlock()
+	c.close()
+}
+
+type request struct {
+	Action   string          `json:"action"`
+	Filter   Filter          `json:"filter"`
+	ID       string          `json:"id"`
+	Command  string          `json:"command"`
+	Instance string          `json:"instance"`
+	Params   json.RawMessage `json:"params"`
+}
+
+type result struct {
+	Type  string `json:"type"`
+	ID    string `json:"id,omitempty"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func (b *Bridge) reader(c *client) {
+	for {
+		var req request
+		if err := c.conn.ReadJSON(&req); err != nil {
+			var syntax *json.SyntaxError
+			if errors.As(err, &syntax) {
+				c.reply(result{Type: "result", Error: err.Error()})
+
+				continue
+			}
+
+			return
+		}
+
+		var err error
+
+		switch req.Action {
+		case "filter":
+			c.setFilter(req.Filter)
+		case "command":
+			err = b.command(c, req)
+		default:
+			err = ErrUnknownAction
+		}
+
+		res := result{Type: "result", ID: req.ID, OK: err == nil}
+		if err != nil {
+			res.Error = err.Error()
+		}
+
+		c.reply(res)
+	}
+}
+
+func (b *Bridge) command(c *client, req request) error {
+	if !c.authorized {
+		return ErrNotAuthorized
+	}
+
+	inst, ok := b.instances.Get(req.Instance)
+	if !ok || inst.Addr == nil {
+		return ErrUnknownInstance
+	}
+
+	msg, err := command.Encode(req.Command, req.Instance, req.Params)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), writeTimeout)
+	defer cancel()
+
+	return b.w.WriteTo(ctx, msg, inst.Addr)
+}
+
+type client struct {
+	conn       *websocket.Conn
+	out        chan []byte
+	done       chan struct{}
+	once       sync.Once
+	mu         sync.Mutex
+	filter     Filter
+	authorized bool
+}
+
+func (c *client) getFilter() Filter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.filter
+}
+
+func (c *client) setFilter(f Filter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.filter = f
+}
+
+// send queues data, disconnecting the client when it does not keep up.
+func (c *client) send(data []byte) {
+	select {
+	case c.out <- data:
+	case <-c.done:
+	default:
+		c.close()
+	}
+}
+
+func (c *client) reply(r result) {
+	data, _ := json.Marshal(r)
+	c.send(data)
+}
+
+func (c *client) writer(log logger) {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case data := <-c.out:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+				log.Println("wsbridge: write:", err)
+				c.close()
+
+				return
+			}
+		case <-ping.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout)); err != nil {
+				c.close()
+
+				return
+			}
+		}
+	}
+}
+
+func (c *client) close() {
+	c.once.Do(func() {
+		close(c.done)
+		c.conn.Close()
+	})
+}
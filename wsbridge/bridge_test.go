@@ -0,0 +1,139 @@
+package wsbridge
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/logocomune/wsjtx/instance"
+	"github.com/logocomune/wsjtx/message"
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+type fakeWriter struct {
+	mu   sync.Mutex
+	sent [][]byte
+}
+
+func (f *fakeWriter) WriteTo(_ context.Context, msg []byte, _ *net.UDPAddr) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, msg)
+
+	return nil
+}
+
+func decode(text string) udpserver.Message {
+	return udpserver.Message{Response: message.Response{
+		ResponseType: message.DecodeType,
+		Message:      message.DecodeResponse{ID: "WSJT-X", Message: text},
+	}}
+}
+
+func dial(t *testing.T, url string) *websocket.Conn {
+	t.Helper()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(url, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+
+	return conn
+}
+
+func TestBridge(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	registry := instance.NewRegistry(nil, logger)
+	registry.Heartbeat(&net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 55000}, message.HeartbeatResponse{ID: "WSJT-X"})
+
+	w := &fakeWriter{}
+	b := NewBridge(w, registry, logger, WithAuthorizer(func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer secret" || r.URL.Query().Get("token") == "secret"
+	}))
+	defer b.Close()
+
+	srv := httptest.NewServer(b)
+	defer srv.Close()
+
+	viewer := dial(t, srv.URL+"?types=DECODE&callsign=k1*")
+	defer viewer.Close()
+
+	operator := dial(t, srv.URL+"?token=secret")
+	defer operator.Close()
+
+	for deadline := time.Now().Add(time.Second); ; {
+		b.mu.RLock()
+		n := len(b.clients)
+		b.mu.RUnlock()
+
+		if n == 2 || time.Now().After(deadline) {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	b.Publish(udpserver.Message{Response: message.Response{
+		ResponseType: message.StatusType,
+		Message:      message.StatusResponse{ID: "WSJT-X", Dial: 14074000},
+	}})
+	b.Publish(decode("CQ IU5PMP JN53"))
+	b.Publish(decode("CQ K1ABC FN42"))
+
+	var got struct {
+		Type    string                 `json:"type"`
+		Band    string                 `json:"band"`
+		Message message.DecodeResponse `json:"message"`
+	}
+	if err := viewer.ReadJSON(&got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Type != message.DecodeType || got.Band != "20m" || got.Message.Message != "CQ K1ABC FN42" {
+		t.Errorf("viewer got %+v, want the K1ABC decode on 20m", got)
+	}
+
+	cmd := request{Action: "command", ID: "1", Command: "halt_tx", Instance: "WSJT-X", Params: json.RawMessage(`{"auto":true}`)}
+
+	var res result
+	if err := viewer.WriteJSON(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := viewer.ReadJSON(&res); err != nil || res.OK || res.Error != ErrNotAuthorized.Error() {
+		t.Errorf("viewer command result = %+v, %v", res, err)
+	}
+
+	for i := 0; i < 3; i++ {
+		var m map[string]interface{}
+		if err := operator.ReadJSON(&m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := operator.WriteJSON(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := operator.ReadJSON(&res); err != nil || !res.OK || res.ID != "1" {
+		t.Errorf("operator command result = %+v, %v", res, err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if want := message.EncodeHaltTX(message.HaltTXMessage{ID: "WSJT-X", Auto: true}); len(w.sent) != 1 || string(w.sent[0]) != string(want) {
+		t.Errorf("sent %x, want %x", w.sent, want)
+	}
+}
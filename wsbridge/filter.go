@@ -0,0 +1,26 @@
+package wsbridge
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/logocomune/wsjtx/udpserver"
+)
+
+// Filter selects the messages sent to a client. Empty fields match everything.
+type Filter = udpserver.Filter
+
+// filterFromQuery reads a Filter from the types, instance, band and callsign parameters.
+func filterFromQuery(q url.Values) Filter {
+	f := Filter{
+		Instance: q.Get("instance"),
+		Band:     q.Get("band"),
+		Callsign: q.Get("callsign"),
+	}
+
+	if types := q.Get("types"); types != "" {
+		f.Types = strings.Split(types, ",")
+	}
+
+	return f
+}